@@ -0,0 +1,58 @@
+// Package analytics derives aggregate views, e.g. time series, from fetched Reddit data.
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// KarmaPoint represents the approximate karma of an author right after one of their submissions or comments
+type KarmaPoint struct {
+	Time time.Time
+	// Thing is the submission or comment contributing to the karma at this point
+	Thing *redditreadgo.Thing
+	// LinkKarma and CommentKarma are cumulative, as is Karma, their sum
+	LinkKarma    int
+	CommentKarma int
+	Karma        int
+}
+
+// KarmaOverTime reconstructs the karma series of an author from their history, e.g. as returned by HistoryOf,
+// oldest first. Each item contributes its current score less the author's own vote, which Reddit does not count,
+// so the series is approximate: it ignores when votes were cast and items missing from the history.
+func KarmaOverTime(history []*redditreadgo.Thing) []KarmaPoint {
+
+	things := make([]*redditreadgo.Thing, 0, len(history))
+	for _, thing := range history {
+		if thing != nil && (thing.Submission != nil || thing.Comment != nil) {
+			things = append(things, thing)
+		}
+	}
+	sort.SliceStable(things, func(i, j int) bool { return things[i].Created() < things[j].Created() })
+
+	points := make([]KarmaPoint, len(things))
+	linkKarma, commentKarma := 0, 0
+	for index, thing := range things {
+		if thing.Submission != nil {
+			linkKarma += int(thing.Submission.Score) - 1
+		} else {
+			commentKarma += thing.Comment.Score - 1
+		}
+
+		points[index] = KarmaPoint{
+			Time:         unixTime(thing.Created()),
+			Thing:        thing,
+			LinkKarma:    linkKarma,
+			CommentKarma: commentKarma,
+			Karma:        linkKarma + commentKarma,
+		}
+	}
+
+	return points
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+}
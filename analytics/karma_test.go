@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+func TestKarmaOverTime(t *testing.T) {
+
+	submission := &redditreadgo.Thing{Kind: "t3", Submission: &redditreadgo.Submission{ID: "s1", Score: 10, CreatedUTC: 200}}
+	older := &redditreadgo.Thing{Kind: "t1", Comment: &redditreadgo.Comment{ID: "c1", Score: 5, CreatedUTC: 100}}
+	newer := &redditreadgo.Thing{Kind: "t1", Comment: &redditreadgo.Comment{ID: "c2", Score: -3, CreatedUTC: 300}}
+	empty := &redditreadgo.Thing{Kind: "t1"}
+
+	points := KarmaOverTime([]*redditreadgo.Thing{newer, nil, submission, empty, older})
+
+	expected := []KarmaPoint{
+		{Time: time.Unix(100, 0).UTC(), Thing: older, LinkKarma: 0, CommentKarma: 4, Karma: 4},
+		{Time: time.Unix(200, 0).UTC(), Thing: submission, LinkKarma: 9, CommentKarma: 4, Karma: 13},
+		{Time: time.Unix(300, 0).UTC(), Thing: newer, LinkKarma: 9, CommentKarma: 0, Karma: 9},
+	}
+
+	if len(points) != len(expected) {
+		t.Fatalf("expected %d points, nil and empty things skipped, got %d", len(expected), len(points))
+	}
+	for index, point := range points {
+		if point != expected[index] {
+			t.Errorf("point %d: expected %+v, got %+v", index, expected[index], point)
+		}
+	}
+}
+
+func TestKarmaOverTimeWithoutHistory(t *testing.T) {
+
+	if points := KarmaOverTime(nil); len(points) != 0 {
+		t.Errorf("expected no points, got %v", points)
+	}
+}
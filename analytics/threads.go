@@ -0,0 +1,83 @@
+package analytics
+
+import (
+	"strings"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// Thread represents a submission along with its comment tree
+type Thread struct {
+	Submission *redditreadgo.Submission
+	Comments   []*redditreadgo.Comment
+}
+
+// ThreadMetrics represents figures derived from the comment tree of a thread, collapsed stubs excluded
+type ThreadMetrics struct {
+	Comments int
+	// UniqueCommenters is the no. of distinct authors, deleted ones excluded
+	UniqueCommenters int
+	// MaxDepth is the no. of levels of the tree, 0 when there are no comments
+	MaxDepth int
+	// TopComment is the highest scored comment at any depth, nil when there are no comments
+	TopComment      *redditreadgo.Comment
+	TopCommentScore int
+}
+
+// Join attaches the comment trees, keyed by submission id with or without its t3_ prefix as in
+// redditreadgo.BulkComments, to their submissions. Submissions without comments get an empty tree.
+func Join(submissions []*redditreadgo.Submission, comments map[string][]*redditreadgo.Comment) []*Thread {
+
+	byID := make(map[string][]*redditreadgo.Comment, len(comments))
+	for id, tree := range comments {
+		byID[strings.TrimPrefix(id, "t3_")] = tree
+	}
+
+	threads := make([]*Thread, 0, len(submissions))
+	for _, submission := range submissions {
+		if submission == nil {
+			continue
+		}
+		tree, ok := byID[submission.ID]
+		if !ok || tree == nil {
+			tree = []*redditreadgo.Comment{}
+		}
+		threads = append(threads, &Thread{Submission: submission, Comments: tree})
+	}
+
+	return threads
+}
+
+// Metrics computes the metrics of the comment tree of the thread
+func (t *Thread) Metrics() ThreadMetrics {
+
+	metrics := ThreadMetrics{}
+	commenters := make(map[string]bool)
+
+	var walk func(comments []*redditreadgo.Comment, depth int)
+	walk = func(comments []*redditreadgo.Comment, depth int) {
+		for _, comment := range comments {
+			if comment == nil || comment.IsMore() {
+				continue
+			}
+
+			metrics.Comments++
+			if depth > metrics.MaxDepth {
+				metrics.MaxDepth = depth
+			}
+			if comment.Author != redditreadgo.DeletedAuthor && len(comment.Author) > 0 {
+				commenters[comment.Author] = true
+			}
+			if metrics.TopComment == nil || comment.Score > metrics.TopCommentScore {
+				metrics.TopComment = comment
+				metrics.TopCommentScore = comment.Score
+			}
+
+			walk(comment.Replies, depth+1)
+		}
+	}
+	walk(t.Comments, 1)
+
+	metrics.UniqueCommenters = len(commenters)
+	return metrics
+}
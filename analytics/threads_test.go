@@ -0,0 +1,68 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+func TestJoin(t *testing.T) {
+
+	first := &redditreadgo.Submission{ID: "s1"}
+	second := &redditreadgo.Submission{ID: "s2"}
+	third := &redditreadgo.Submission{ID: "s3"}
+	tree := []*redditreadgo.Comment{{ID: "c1"}}
+
+	threads := Join([]*redditreadgo.Submission{first, nil, second, third}, map[string][]*redditreadgo.Comment{
+		"t3_s1": tree,
+		"s2":    nil,
+	})
+
+	if len(threads) != 3 {
+		t.Fatalf("expected 3 threads, nil submissions skipped, got %d", len(threads))
+	}
+	if threads[0].Submission != first || len(threads[0].Comments) != 1 || threads[0].Comments[0] != tree[0] {
+		t.Errorf("expected the tree keyed by fullname joined to s1, got %+v", threads[0])
+	}
+	for _, thread := range threads[1:] {
+		if thread.Comments == nil || len(thread.Comments) != 0 {
+			t.Errorf("expected an empty tree for %s, got %#v", thread.Submission.ID, thread.Comments)
+		}
+	}
+}
+
+func TestThreadMetrics(t *testing.T) {
+
+	top := &redditreadgo.Comment{ID: "c3", Author: "gopher", Score: 50}
+	thread := &Thread{Comments: []*redditreadgo.Comment{
+		{ID: "c1", Author: "gopher", Score: 3, Replies: []*redditreadgo.Comment{
+			{ID: "c2", Author: redditreadgo.DeletedAuthor, Score: 1, Replies: []*redditreadgo.Comment{top}},
+			{More: &redditreadgo.MoreComments{Count: 40}},
+		}},
+		{ID: "c4", Author: "spez", Score: -2},
+		{ID: "c5", Author: "", Score: 0},
+		nil,
+	}}
+
+	metrics := thread.Metrics()
+	if metrics.Comments != 5 {
+		t.Errorf("expected 5 comments, stubs excluded, got %d", metrics.Comments)
+	}
+	if metrics.UniqueCommenters != 2 {
+		t.Errorf("expected 2 commenters, deleted and empty authors excluded, got %d", metrics.UniqueCommenters)
+	}
+	if metrics.MaxDepth != 3 {
+		t.Errorf("expected a depth of 3, got %d", metrics.MaxDepth)
+	}
+	if metrics.TopComment != top || metrics.TopCommentScore != 50 {
+		t.Errorf("expected c3 as the top comment, got %+v with %d", metrics.TopComment, metrics.TopCommentScore)
+	}
+}
+
+func TestThreadMetricsWithoutComments(t *testing.T) {
+
+	metrics := (&Thread{Comments: []*redditreadgo.Comment{}}).Metrics()
+	if metrics != (ThreadMetrics{}) {
+		t.Errorf("expected zero metrics, got %+v", metrics)
+	}
+}
@@ -0,0 +1,166 @@
+package redditreadgo
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// RawPage represents a raw JSON page as received from Reddit, before decoding
+type RawPage struct {
+	URL  string
+	Time time.Time
+	// SHA256 is the hex encoded SHA-256 digest of Body
+	SHA256 string
+	Body   []byte
+}
+
+// RawArchive stores raw pages so that datasets can be re-parsed later without new requests
+type RawArchive interface {
+	Store(page RawPage) error
+}
+
+// DirArchive stores every raw page as a gzip file in a directory, named after the URL and the fetch time.
+// The gzip header carries the URL and the fetch time, making every file self-describing. It is safe for concurrent use.
+type DirArchive struct {
+	dir string
+}
+
+// NewDirArchive creates an archive storing pages in the given directory, creating it if needed
+func NewDirArchive(dir string) (*DirArchive, error) {
+
+	if len(dir) == 0 {
+		return nil, errors.New("dir cannot be null nor empty")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &DirArchive{dir: dir}, nil
+}
+
+// Store writes the page compressed to a new file, named <url key>-<unix nano time>.json.gz
+func (a *DirArchive) Store(page RawPage) error {
+
+	name := fmt.Sprintf("%s-%019d.json.gz", archiveKey(page.URL), page.Time.UnixNano())
+
+	file, err := ioutil.TempFile(a.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	writer := gzip.NewWriter(file)
+	writer.Name = page.URL
+	writer.Comment = page.SHA256
+	writer.ModTime = page.Time
+
+	if _, err := writer.Write(page.Body); err != nil {
+		file.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filepath.Join(a.dir, name))
+}
+
+// Load returns the most recently stored page of the given URL, failing with NotArchivedError if there is none.
+// The body is verified against the digest it was stored with.
+func (a *DirArchive) Load(url string) (*RawPage, error) {
+
+	names, err := filepath.Glob(filepath.Join(a.dir, archiveKey(url)+"-*.json.gz"))
+	if err != nil {
+		return nil, err
+	}
+
+	// names embed zero padded fetch times, the lexical order is the chronological one
+	sort.Strings(names)
+	for index := len(names) - 1; index >= 0; index-- {
+		page, err := readArchivedPage(names[index])
+		if err != nil {
+			return nil, err
+		}
+		if page.URL == url {
+			return page, nil
+		}
+	}
+
+	return nil, &NotArchivedError{URL: url}
+}
+
+// NotArchivedError is returned when an archive holds no page of the requested URL
+type NotArchivedError struct {
+	URL string
+}
+
+func (e *NotArchivedError) Error() string {
+	return fmt.Sprintf("no archived page of %s", e.URL)
+}
+
+func readArchivedPage(name string) (*RawPage, error) {
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read archived page %s: %v", name, err)
+	}
+	defer reader.Close()
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read archived page %s: %v", name, err)
+	}
+
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+	if len(reader.Comment) > 0 && reader.Comment != hash {
+		return nil, fmt.Errorf("archived page %s is corrupt, its digest does not match", name)
+	}
+
+	return &RawPage{URL: reader.Name, Time: reader.ModTime, SHA256: hash, Body: body}, nil
+}
+
+// WithRawArchive sets the archive storing every raw JSON page before it is decoded. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) WithRawArchive(archive RawArchive) {
+	c.archive = archive
+}
+
+func (c *ReadOnlyRedditClient) store(url string, start time.Time, body []byte, hash string) error {
+
+	if c.archive == nil {
+		return nil
+	}
+
+	// the body lives in a pooled buffer, archives get their own copy
+	page := RawPage{URL: url, Time: start, SHA256: hash, Body: append([]byte(nil), body...)}
+	if err := c.archive.Store(page); err != nil {
+		return fmt.Errorf("cannot archive raw response of %s: %v", url, err)
+	}
+
+	return nil
+}
+
+// archiveKey returns a file name friendly key of the given URL
+func archiveKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:16])
+}
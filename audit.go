@@ -0,0 +1,120 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditEntry represents the record of a single HTTP request made by the client
+type AuditEntry struct {
+	Time       time.Time     `json:"time"`
+	Method     string        `json:"method"`
+	URL        string        `json:"url"`
+	StatusCode int           `json:"status"`
+	Duration   time.Duration `json:"duration"`
+	// Bytes is the no. of decompressed body bytes read
+	Bytes int `json:"bytes"`
+	// SHA256 is the hex encoded SHA-256 digest of the decompressed body, set when it was read in full
+	SHA256 string `json:"sha256,omitempty"`
+	// RateLimitUsed, RateLimitRemaining and RateLimitReset are the raw X-Ratelimit-* response headers
+	RateLimitUsed      string `json:"ratelimit_used,omitempty"`
+	RateLimitRemaining string `json:"ratelimit_remaining,omitempty"`
+	RateLimitReset     string `json:"ratelimit_reset,omitempty"`
+	Error              string `json:"error,omitempty"`
+	// Family and Subreddit label the request, Subreddit being empty for requests not targeting one, see SubredditLabels
+	Family    EndpointFamily `json:"family,omitempty"`
+	Subreddit string         `json:"subreddit,omitempty"`
+}
+
+// AuditSink records audit entries, e.g. for compliance or for debugging long crawls
+type AuditSink interface {
+	Record(entry AuditEntry) error
+}
+
+// NDJSONAuditSink writes audit entries as newline delimited JSON. It is safe for concurrent use.
+type NDJSONAuditSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewNDJSONAuditSink creates an audit sink writing to the given writer
+func NewNDJSONAuditSink(writer io.Writer) *NDJSONAuditSink {
+	return &NDJSONAuditSink{writer: writer}
+}
+
+// OpenAuditLog opens, or creates, an append-only audit log file at the given path
+func OpenAuditLog(path string) (*NDJSONAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewNDJSONAuditSink(file), nil
+}
+
+// Record writes the entry as a single JSON line
+func (s *NDJSONAuditSink) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, err = s.writer.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the underlying writer, if it can be closed
+func (s *NDJSONAuditSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if closer, ok := s.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Audit sets the sink recording every HTTP request made by the client. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) Audit(sink AuditSink) {
+	c.audit = sink
+}
+
+func (c *ReadOnlyRedditClient) record(method string, url string, start time.Time, response *http.Response, bytes int, hash string, err error) {
+
+	if c.audit == nil {
+		return
+	}
+
+	family, subreddit := c.endpointLabels(url)
+	entry := AuditEntry{
+		Time:      start,
+		Method:    method,
+		URL:       url,
+		Duration:  time.Since(start),
+		Bytes:     bytes,
+		SHA256:    hash,
+		Family:    family,
+		Subreddit: subreddit,
+	}
+
+	if response != nil {
+		entry.StatusCode = response.StatusCode
+		entry.RateLimitUsed = response.Header.Get("X-Ratelimit-Used")
+		entry.RateLimitRemaining = response.Header.Get("X-Ratelimit-Remaining")
+		entry.RateLimitReset = response.Header.Get("X-Ratelimit-Reset")
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if auditErr := c.audit.Record(entry); auditErr != nil && c.logger != nil {
+		c.logger.Debugf("cannot record audit entry for %s: %v", url, auditErr)
+	}
+}
@@ -0,0 +1,90 @@
+package redditreadgo
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+
+	page := listingPage(5, 0)
+	fake := &fakeReddit{expiresIn: 3600, answer: func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/r/golang/new" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ratelimit-Used", "4")
+		w.Header().Set("X-Ratelimit-Remaining", "596")
+		w.Header().Set("X-Ratelimit-Reset", "120")
+		w.Write(page)
+	}}
+	client := newTestClient(t, fake)
+
+	dir, err := ioutil.TempDir("", "redditreadgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.ndjson")
+
+	// the second sink appends to the log written by the first one
+	for _, subreddit := range []string{"golang", "rust"} {
+		sink, err := OpenAuditLog(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.Audit(sink)
+		client.SubmissionsTo(subreddit, NewSubmissions, AllTime, ListingOptions{})
+		if err := sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("cannot decode line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	digest := sha256.Sum256(page)
+	found := entries[0]
+	if found.Method != "GET" || !strings.HasPrefix(found.URL, QueryURL+"/r/golang/new") || found.StatusCode != http.StatusOK {
+		t.Errorf("unexpected entry for the page: %+v", found)
+	}
+	if found.SHA256 != hex.EncodeToString(digest[:]) || found.Bytes != len(page) || len(found.Error) > 0 {
+		t.Errorf("expected the digest %x of %d bytes, got %+v", digest, len(page), found)
+	}
+	if found.RateLimitUsed != "4" || found.RateLimitRemaining != "596" || found.RateLimitReset != "120" {
+		t.Errorf("expected the rate limit headers, got %+v", found)
+	}
+
+	missing := entries[1]
+	if !strings.HasPrefix(missing.URL, QueryURL+"/r/rust/new") || missing.StatusCode != http.StatusNotFound || len(missing.Error) == 0 {
+		t.Errorf("unexpected entry for the missing page: %+v", missing)
+	}
+	if len(missing.SHA256) > 0 {
+		t.Errorf("expected no digest for an error page, got %s", missing.SHA256)
+	}
+}
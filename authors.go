@@ -0,0 +1,195 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// DeletedAuthor is the author name Reddit reports for submissions whose account was deleted
+const DeletedAuthor = "[deleted]"
+
+// AuthorStatus represents the state of the account behind an author name
+type AuthorStatus string
+
+const (
+	// AuthorUnresolved value, the account was not looked up
+	AuthorUnresolved AuthorStatus = ""
+	// AuthorActive value
+	AuthorActive AuthorStatus = "active"
+	// AuthorDeleted value
+	AuthorDeleted AuthorStatus = "deleted"
+	// AuthorSuspended value
+	AuthorSuspended AuthorStatus = "suspended"
+	// AuthorNotFound value, the name is shown but the account cannot be retrieved, e.g. shadow banned
+	AuthorNotFound AuthorStatus = "not_found"
+)
+
+// AuthorInfo represents the resolved account of an author
+type AuthorInfo struct {
+	Name     string
+	Fullname string
+	Status   AuthorStatus
+}
+
+// AuthorInfo returns the author account as resolved by ReadOnlyRedditClient.ResolveAuthors. Unresolved submissions
+// only tell deleted authors apart.
+func (s *Submission) AuthorInfo() *AuthorInfo {
+	if s.authorInfo != nil {
+		return s.authorInfo
+	}
+
+	info := &AuthorInfo{Name: s.Author, Fullname: s.AuthorFullname}
+	if s.Author == DeletedAuthor {
+		info.Status = AuthorDeleted
+	}
+	return info
+}
+
+// ResolveAuthors looks up the accounts of the authors of the given submissions, so that Submission.AuthorInfo can
+// distinguish deleted, suspended and existing accounts. Lookups are cached by the client, each author costs at most
+// one request.
+func (c *ReadOnlyRedditClient) ResolveAuthors(submissions []*Submission) error {
+
+	for _, submission := range submissions {
+		if submission == nil {
+			continue
+		}
+
+		info := submission.AuthorInfo()
+		if info.Status == AuthorUnresolved {
+			status, err := c.authorStatus(submission.Author)
+			if err != nil {
+				return err
+			}
+			info.Status = status
+		}
+
+		submission.authorInfo = info
+	}
+
+	return nil
+}
+
+// AboutUser returns the account of the given user. Suspended accounts only report their name and IsSuspended.
+func (c *ReadOnlyRedditClient) AboutUser(username string) (*Account, error) {
+
+	if err := validateAuthor(username); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/user/%s/about?raw_json=1", QueryURL, username)
+
+	type Response struct {
+		Kind string
+		Data *Account
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return nil, fmt.Errorf("no account returned for %s", username)
+	}
+
+	return response.Data, nil
+}
+
+// TrophiesOf returns the trophies of the given user
+func (c *ReadOnlyRedditClient) TrophiesOf(username string) ([]*Trophy, error) {
+
+	if err := validateAuthor(username); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/api/v1/user/%s/trophies?raw_json=1", QueryURL, username)
+
+	type Response struct {
+		Kind string
+		Data struct {
+			Trophies []struct {
+				Kind string
+				Data *Trophy
+			}
+		}
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	trophies := make([]*Trophy, 0, len(response.Data.Trophies))
+	for _, trophy := range response.Data.Trophies {
+		if trophy.Data != nil {
+			trophies = append(trophies, trophy.Data)
+		}
+	}
+
+	return trophies, nil
+}
+
+func (c *ReadOnlyRedditClient) authorStatus(author string) (AuthorStatus, error) {
+
+	if c == nil {
+		return AuthorUnresolved, errors.New("client is not logged in, use NewReadOnlyRedditClient")
+	}
+
+	if status, ok := c.authors.get(author); ok {
+		return status, nil
+	}
+
+	if err := validateAuthor(author); err != nil {
+		return AuthorUnresolved, err
+	}
+
+	status := AuthorActive
+	account, err := c.AboutUser(author)
+	if err != nil {
+		statusErr, ok := err.(*StatusError)
+		if !ok || (statusErr.StatusCode != http.StatusNotFound && statusErr.StatusCode != http.StatusForbidden) {
+			return AuthorUnresolved, err
+		}
+		status = AuthorNotFound
+	} else if account.IsSuspended {
+		status = AuthorSuspended
+	}
+
+	c.authors.set(author, status)
+	return status, nil
+}
+
+// authorCache keeps the resolved status per author. A nil value caches nothing.
+type authorCache struct {
+	mutex    sync.Mutex
+	statuses map[string]AuthorStatus
+}
+
+func (a *authorCache) get(author string) (AuthorStatus, bool) {
+	if a == nil {
+		return AuthorUnresolved, false
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	status, ok := a.statuses[author]
+	return status, ok
+}
+
+func (a *authorCache) set(author string, status AuthorStatus) {
+	if a == nil {
+		return
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.statuses == nil {
+		a.statuses = make(map[string]AuthorStatus)
+	}
+	a.statuses[author] = status
+}
@@ -0,0 +1,120 @@
+package redditreadgo
+
+import (
+	"errors"
+	"sync"
+)
+
+// DefaultConcurrency specifies the no. of requests CommentsForAll makes at once when not told otherwise
+const DefaultConcurrency = 4
+
+// BulkCommentsOptions represents the settings of CommentsForAll
+type BulkCommentsOptions struct {
+	Sort   CommentSort
+	Params CommentOptions
+	// Concurrency is the maximum no. of comment trees fetched at once, DefaultConcurrency if 0
+	Concurrency int
+	// MaxComments caps the total no. of comments retrieved, collapsed stubs excluded; 0 means unlimited
+	MaxComments int
+}
+
+// BulkComments represents the outcome of CommentsForAll, keyed by submission id as given
+type BulkComments struct {
+	Comments map[string][]*Comment
+	// Errors are the failures of the submissions whose comments could not be retrieved
+	Errors map[string]error
+	// Skipped are the submissions not queried because MaxComments was reached
+	Skipped []string
+	// Total is the no. of comments retrieved, collapsed stubs excluded
+	Total int
+}
+
+// CommentsForAll fetches the comment trees of the given submissions, several at once, all requests sharing the
+// throttle of the client. Failures of single submissions are reported in the result rather than aborting the others.
+// Once MaxComments is reached no further submission is queried, though the trees already being fetched are kept.
+func (c *ReadOnlyRedditClient) CommentsForAll(submissionIDs []string, opts BulkCommentsOptions) (*BulkComments, error) {
+
+	if opts.Concurrency < 0 {
+		return nil, errors.New("concurrency cannot be negative")
+	}
+
+	if opts.MaxComments < 0 {
+		return nil, errors.New("maxComments cannot be negative")
+	}
+
+	if err := opts.Sort.validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := encodeValues(opts.Params); err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency == 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	bulk := &BulkComments{
+		Comments: make(map[string][]*Comment),
+		Errors:   make(map[string]error),
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				comments, err := c.CommentsOf(id, opts.Sort, opts.Params)
+
+				mutex.Lock()
+				if err != nil {
+					bulk.Errors[id] = err
+				} else {
+					bulk.Comments[id] = comments
+					bulk.Total += countComments(comments)
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[string]bool, len(submissionIDs))
+	for _, id := range submissionIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		mutex.Lock()
+		reached := opts.MaxComments > 0 && bulk.Total >= opts.MaxComments
+		mutex.Unlock()
+
+		if reached {
+			bulk.Skipped = append(bulk.Skipped, id)
+			continue
+		}
+
+		jobs <- id
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return bulk, nil
+}
+
+// countComments returns the no. of comments in the given trees, collapsed stubs excluded
+func countComments(comments []*Comment) int {
+	count := 0
+	for _, comment := range comments {
+		if comment == nil || comment.IsMore() {
+			continue
+		}
+		count += 1 + countComments(comment.Replies)
+	}
+	return count
+}
@@ -0,0 +1,57 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCommentsForAllRefreshingToken(t *testing.T) {
+
+	// tokens expiring within a second are refreshed before every request, by whichever worker gets there first
+	fake := &fakeReddit{expiresIn: 1, pages: make(map[string]string)}
+	ids := make([]string, 20)
+	for index := range ids {
+		ids[index] = fmt.Sprintf("s%d", index)
+		fake.pages["/comments/"+ids[index]] = commentsPage(ids[index], 3)
+	}
+
+	client := newTestClient(t, fake)
+	bulk, err := client.CommentsForAll(ids, BulkCommentsOptions{Concurrency: 8})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(bulk.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", bulk.Errors)
+	}
+	if len(bulk.Comments) != len(ids) || bulk.Total != 3*len(ids) {
+		t.Errorf("expected %d trees of 3 comments, got %d trees and %d comments", len(ids), len(bulk.Comments), bulk.Total)
+	}
+	if tokens := atomic.LoadInt64(&fake.tokens); tokens < 2 {
+		t.Errorf("expected the token to be refreshed, %d tokens were handed out", tokens)
+	}
+}
+
+func TestCopiesShareRefreshedToken(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 1, pages: map[string]string{"/comments/abc": commentsPage("abc", 1)}}
+	client := newTestClient(t, fake)
+	before := client.CurrentToken()
+
+	scoped := client.With(WithConsumer("other"))
+	if _, err := scoped.CommentsOf("abc", DefaultCommentSort, CommentOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	after := client.CurrentToken()
+	if after.AccessToken == before.AccessToken {
+		t.Errorf("token refreshed through a copy is not seen by the client, still %s", after.AccessToken)
+	}
+	if current := scoped.CurrentToken(); current.AccessToken != after.AccessToken {
+		t.Errorf("expected the copy and the client to share %s, the copy has %s", after.AccessToken, current.AccessToken)
+	}
+	if client.Token.AccessToken != after.AccessToken || scoped.Token.AccessToken != after.AccessToken {
+		t.Errorf("expected the deprecated Token field to follow the refresh, got %s and %s", client.Token.AccessToken, scoped.Token.AccessToken)
+	}
+}
@@ -0,0 +1,68 @@
+package redditreadgo
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// CallOption represents a setting overriding the client-wide configuration for the calls made through With
+type CallOption func(*ReadOnlyRedditClient)
+
+// WithContext makes the calls abort as soon as the given context is done, including while throttled
+func WithContext(ctx context.Context) CallOption {
+	return func(c *ReadOnlyRedditClient) {
+		c.callContext = ctx
+	}
+}
+
+// WithTimeout bounds every HTTP request made by the calls, throttling included, to the given duration. Calls
+// spanning several requests, e.g. AllSubmissionsTo, apply it to each of them. Disable by setting it to 0.
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(c *ReadOnlyRedditClient) {
+		c.callTimeout = timeout
+	}
+}
+
+// WithConsumer charges the requests of the calls to the given consumer of the quota manager, like Consumer does
+func WithConsumer(name string) CallOption {
+	return func(c *ReadOnlyRedditClient) {
+		c.consumer = name
+	}
+}
+
+// WithAcceptLanguage sends the given Accept-Language header, e.g. "de-DE, de;q=0.9, en;q=0.5", with the requests
+// of the calls, so that locale dependent content such as suggested sorts and translations matches it. Apply it to
+// every call with client = client.With(WithAcceptLanguage(...)). Disable by setting it to "".
+func WithAcceptLanguage(languages string) CallOption {
+	return func(c *ReadOnlyRedditClient) {
+		c.acceptLanguage = strings.TrimSpace(languages)
+	}
+}
+
+// With returns a client applying the given options on top of the configuration of this one, for the calls made
+// through it, e.g. client.With(WithTimeout(5*time.Second)).SubmissionsTo(...).
+// The returned client shares the HTTP client, throttle, logger, events and quota manager of this one.
+func (c *ReadOnlyRedditClient) With(opts ...CallOption) *ReadOnlyRedditClient {
+	scoped := *c
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&scoped)
+		}
+	}
+	return &scoped
+}
+
+// requestContext returns the context a single HTTP request runs in, according to the call options
+func (c *ReadOnlyRedditClient) requestContext() (context.Context, context.CancelFunc) {
+
+	ctx := c.callContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if c.callTimeout > 0 {
+		return context.WithTimeout(ctx, c.callTimeout)
+	}
+	return context.WithCancel(ctx)
+}
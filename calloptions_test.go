@@ -0,0 +1,118 @@
+package redditreadgo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithContextCancelled(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": string(listingPage(5, 0))}}
+	client := newTestClient(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := client.With(WithContext(ctx)).SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err == nil {
+		t.Error("expected an error from a cancelled context")
+	}
+	if requests := len(fake.received()); requests != 0 {
+		t.Errorf("expected no request to reach the server, got %d", requests)
+	}
+
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Errorf("expected the client itself to keep working, got %v", err)
+	}
+}
+
+func TestWithContextWhileThrottled(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": string(listingPage(5, 0))}}
+	client := newTestClient(t, fake)
+	client.Throttle(time.Hour)
+
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := client.With(WithContext(ctx)).SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("expected an error once the context is cancelled")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the call kept waiting for the throttle after the context was cancelled")
+	}
+	if requests := len(fake.received()); requests != 1 {
+		t.Errorf("expected only the first request to reach the server, got %d", requests)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+
+	slow := 500 * time.Millisecond
+	fake := &fakeReddit{expiresIn: 3600, answer: func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("after") == "slow" {
+			select {
+			case <-time.After(slow):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(listingPage(5, 0))
+	}}
+	client := newTestClient(t, fake)
+
+	scoped := client.With(WithTimeout(100 * time.Millisecond))
+	if _, _, err := scoped.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Fatalf("unexpected error within the deadline: %v", err)
+	}
+
+	start := time.Now()
+	if _, _, err := scoped.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{After: "slow"}); err == nil {
+		t.Error("expected an error once the deadline expired")
+	}
+	if elapsed := time.Since(start); elapsed >= slow {
+		t.Errorf("expected the request to be abandoned at the deadline, it took %v", elapsed)
+	}
+
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{After: "slow"}); err != nil {
+		t.Errorf("expected the client itself to wait for the slow page, got %v", err)
+	}
+}
+
+func TestWithAcceptLanguage(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": string(listingPage(5, 0))}}
+	client := newTestClient(t, fake)
+
+	if _, _, err := client.With(WithAcceptLanguage("  de-DE, de;q=0.9 ")).SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := fake.received()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if language := requests[0].Header.Get("Accept-Language"); language != "de-DE, de;q=0.9" {
+		t.Errorf("expected the trimmed Accept-Language header, got %q", language)
+	}
+	if language, ok := requests[1].Header["Accept-Language"]; ok {
+		t.Errorf("expected no Accept-Language header without the option, got %q", language)
+	}
+}
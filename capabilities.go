@@ -0,0 +1,103 @@
+package redditreadgo
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// ClientCredentialsGrant and ReplayGrant specify how a client obtained its access
+const (
+	// ClientCredentialsGrant - application only OAuth, used by NewReadOnlyRedditClient
+	ClientCredentialsGrant = "client_credentials"
+	// ReplayGrant - no access to Reddit at all, pages are served from an archive, see ArchiveReplayClient
+	ReplayGrant = "replay"
+)
+
+// EndpointFamily represents a group of Reddit endpoints sharing their purpose and their OAuth scope
+type EndpointFamily string
+
+const (
+	// ListingFamily - subreddit listings, e.g. SubmissionsTo
+	ListingFamily EndpointFamily = "listing"
+	// CommentsFamily - comment trees, e.g. CommentsOf and MoreChildren
+	CommentsFamily EndpointFamily = "comments"
+	// HistoryFamily - user listings, e.g. SubmissionsOf, CommentsBy and OverviewOf
+	HistoryFamily EndpointFamily = "history"
+	// AboutFamily - user and subreddit details, e.g. ResolveAuthors and StylesheetOf
+	AboutFamily EndpointFamily = "about"
+	// SearchFamily - search and subreddit discovery, e.g. SearchByFlair and SubredditAutocomplete
+	SearchFamily EndpointFamily = "search"
+	// TokenFamily - access token requests
+	TokenFamily EndpointFamily = "token"
+	// StatusFamily - the Reddit status page, see CheckRedditStatus
+	StatusFamily EndpointFamily = "status"
+)
+
+// familyScopes maps the endpoint families to the OAuth scope they require, empty for none
+var familyScopes = map[EndpointFamily]string{
+	ListingFamily:  "read",
+	CommentsFamily: "read",
+	HistoryFamily:  "history",
+	AboutFamily:    "read",
+	SearchFamily:   "read",
+	TokenFamily:    "",
+	StatusFamily:   "",
+}
+
+// Capabilities represents what a client is able to do, so that callers can adapt their features
+type Capabilities struct {
+	// GrantType is ClientCredentialsGrant or ReplayGrant
+	GrantType string
+	// Scopes are the OAuth scopes granted to the token, "*" meaning all of them
+	Scopes []string
+	// Families are the endpoint families the client can query, sorted
+	Families []EndpointFamily
+}
+
+// Supports reports whether the client can query the given endpoint family
+func (c *Capabilities) Supports(family EndpointFamily) bool {
+	for _, supported := range c.Families {
+		if supported == family {
+			return true
+		}
+	}
+	return false
+}
+
+// Capabilities reports the endpoint families and scopes the client supports, based on its grant type and the scopes
+// granted to its token. Tokens not reporting their scopes are assumed to have all of them.
+func (c *ReadOnlyRedditClient) Capabilities() *Capabilities {
+
+	var token *oauth2.Token
+	if c != nil {
+		token = c.tokens.current()
+	}
+	if token == nil {
+		return &Capabilities{}
+	}
+
+	capabilities := &Capabilities{GrantType: c.grantType}
+	if scope, ok := token.Extra("scope").(string); ok {
+		capabilities.Scopes = strings.Fields(scope)
+	}
+
+	granted := make(map[string]bool)
+	for _, scope := range capabilities.Scopes {
+		granted[scope] = true
+	}
+	all := len(capabilities.Scopes) == 0 || granted["*"]
+
+	for family, scope := range familyScopes {
+		switch {
+		case c.grantType == ReplayGrant && (family == TokenFamily || family == StatusFamily):
+			// neither is archived
+		case len(scope) == 0 || all || granted[scope]:
+			capabilities.Families = append(capabilities.Families, family)
+		}
+	}
+
+	sort.Slice(capabilities.Families, func(i, j int) bool { return capabilities.Families[i] < capabilities.Families[j] })
+	return capabilities
+}
@@ -0,0 +1,76 @@
+package redditreadgo
+
+import (
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestCapabilities(t *testing.T) {
+
+	// clientWith returns a client whose token reports the given scopes, none at all when nil
+	clientWith := func(grantType string, scopes *string) *ReadOnlyRedditClient {
+		token := &oauth2.Token{AccessToken: "token"}
+		if scopes != nil {
+			token = token.WithExtra(map[string]interface{}{"scope": *scopes})
+		}
+		return &ReadOnlyRedditClient{grantType: grantType, tokens: &tokenStore{token: token}}
+	}
+	scopes := func(scope string) *string { return &scope }
+
+	families := []EndpointFamily{ListingFamily, CommentsFamily, HistoryFamily, AboutFamily, SearchFamily, TokenFamily, StatusFamily}
+
+	tests := []struct {
+		name      string
+		client    *ReadOnlyRedditClient
+		supported []EndpointFamily
+	}{
+		{"all scopes", clientWith(ClientCredentialsGrant, scopes("*")), families},
+		{"unreported scopes", clientWith(ClientCredentialsGrant, nil), families},
+		{"read scope", clientWith(ClientCredentialsGrant, scopes("read")), []EndpointFamily{ListingFamily, CommentsFamily, AboutFamily, SearchFamily, TokenFamily, StatusFamily}},
+		{"history scope", clientWith(ClientCredentialsGrant, scopes("history")), []EndpointFamily{HistoryFamily, TokenFamily, StatusFamily}},
+		{"read and history scopes", clientWith(ClientCredentialsGrant, scopes("read history")), families},
+		{"unrelated scope", clientWith(ClientCredentialsGrant, scopes("identity")), []EndpointFamily{TokenFamily, StatusFamily}},
+		{"replay", clientWith(ReplayGrant, scopes("*")), []EndpointFamily{ListingFamily, CommentsFamily, HistoryFamily, AboutFamily, SearchFamily}},
+		{"no token", &ReadOnlyRedditClient{grantType: ClientCredentialsGrant}, nil},
+		{"nil client", nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capabilities := test.client.Capabilities()
+
+			supported := make(map[EndpointFamily]bool)
+			for _, family := range test.supported {
+				supported[family] = true
+			}
+			for _, family := range families {
+				if capabilities.Supports(family) != supported[family] {
+					t.Errorf("%s: expected supported %v, got %v", family, supported[family], !supported[family])
+				}
+			}
+
+			if len(capabilities.Families) != len(test.supported) {
+				t.Errorf("expected %d families, got %v", len(test.supported), capabilities.Families)
+			}
+			for index := 1; index < len(capabilities.Families); index++ {
+				if capabilities.Families[index-1] >= capabilities.Families[index] {
+					t.Errorf("expected sorted families, got %v", capabilities.Families)
+				}
+			}
+		})
+	}
+}
+
+func TestCapabilitiesOfLoggedInClient(t *testing.T) {
+
+	client := newTestClient(t, &fakeReddit{expiresIn: 3600})
+	capabilities := client.Capabilities()
+
+	if capabilities.GrantType != ClientCredentialsGrant || len(capabilities.Scopes) != 1 || capabilities.Scopes[0] != "*" {
+		t.Errorf("expected the client credentials grant with all scopes, got %+v", capabilities)
+	}
+	if !capabilities.Supports(HistoryFamily) || capabilities.Supports(EndpointFamily("unknown")) {
+		t.Errorf("unexpected families %v", capabilities.Families)
+	}
+}
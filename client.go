@@ -1,23 +1,22 @@
 package redditreadgo
 
 import (
-	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"mime"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/beefsack/go-rate"
-	"github.com/google/go-querystring/query"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 // TokenURL specifies default Reddit access token URL
@@ -26,25 +25,84 @@ const TokenURL = "https://www.reddit.com/api/v1/access_token"
 // QueryURL specifies default Reddit query URL
 const QueryURL = "https://oauth.reddit.com"
 
+// StatusURL specifies the Reddit status page API URL
+const StatusURL = "https://www.redditstatus.com/api/v2/status.json"
+
 // DefaultSliceSize specifies the size of the slice of submission retrieved when querying
 const DefaultSliceSize = 100
 
+// MinSliceSize specifies the smallest slice size the client falls back to when large slices fail to decode
+const MinSliceSize = 10
+
+// MaxResponseSize specifies the maximum size, in bytes, of a decompressed response body
+const MaxResponseSize = 1 << 20
+
+// Logger represents the logging behaviour used by the client. A *logrus.Logger satisfies it, as do most leveled loggers.
+type Logger interface {
+	Debug(args ...interface{})
+	Debugf(format string, args ...interface{})
+}
+
+// StatusError is returned when Reddit answers a request with a non-successful HTTP status
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("cannot do get request, status: %v", e.Status)
+}
+
+// Limiter represents the throttling applied before each HTTP request. A *rate.Limiter from golang.org/x/time/rate satisfies it.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
 // ReadOnlyRedditClient represents an OAuth, read-only session with reddit.
 type ReadOnlyRedditClient struct {
-	Token        *oauth2.Token
-	Cookie       *http.Cookie
-	clientID     string
-	clientSecret string
-	userAgent    string
-	throttle     *rate.RateLimiter
-	logger       *logrus.Logger
+	// Token is the token the client authorizes requests with, updated in place when refreshed.
+	//
+	// Deprecated: reading it while requests are running is a data race, and assigning it has no effect; use
+	// CurrentToken instead.
+	Token           *oauth2.Token
+	clientID        string
+	clientSecret    string
+	userAgent       string
+	httpClient      *http.Client
+	throttle        Limiter
+	logger          Logger
+	events          *EventBus
+	quota           *QuotaManager
+	sliceSizes      *sliceSizes
+	authors         *authorCache
+	consumer        string
+	listingDefaults ListingOptions
+	userAgents      *userAgentRotation
+	audit           AuditSink
+	maxResponseSize int
+	archive         RawArchive
+	grantType       string
+	callContext     context.Context
+	callTimeout     time.Duration
+	tokens          *tokenStore
+	rateLimits      *rateLimitState
+	subredditLabels *subredditLabels
+	lowRateLimit    int
+	autoSlow        bool
+	gateway         GatewayOptions
+	acceptLanguage  string
+	beforePage      PageHook
+	afterPage       PageHook
+	pages           *pageTracker
 }
 
-// IReadOnlyRedditClient defines behaviour for an OAuth, read-only session with reddit.
+// IReadOnlyRedditClient defines behaviour for an OAuth, read-only session with reddit. Besides the logger and the
+// throttle, it only reads; the rest of the configuration, e.g. Gateway or Audit, is specific to ReadOnlyRedditClient.
 type IReadOnlyRedditClient interface {
 
 	// Logger sets the logger. Optional, useful for debugging purposes.
-	Logger(logger *logrus.Logger)
+	Logger(logger Logger)
 
 	// Throttle sets the interval of each HTTP request. Disable by setting interval to 0. Disabled by default.
 	Throttle(interval time.Duration)
@@ -60,11 +118,187 @@ type IReadOnlyRedditClient interface {
 
 	// SubmissionsOf returns the submissions of the given author, considering popularity sort, age sort, and listing options
 	SubmissionsOf(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error)
+
+	// Submission returns the submission with the given id, or fullname
+	Submission(id string) (*Submission, error)
+
+	// SubmissionWithComments returns the submission with the given id, or fullname, along with its comment tree
+	SubmissionWithComments(id string, opts CommentOptions) (*Submission, []*Comment, error)
+
+	// MultisOf returns the public multireddits of the given user
+	MultisOf(username string) ([]*Multireddit, error)
+
+	// SubmissionsToMulti returns the submissions to the given multireddit of the given user, considering popularity sort, age sort, and listing options
+	SubmissionsToMulti(username, multiName string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error)
+
+	// LiveThread returns the metadata of the given live thread
+	LiveThread(threadID string) (*LiveThread, error)
+
+	// LiveUpdatesOf returns the updates of the given live thread, newest first, considering listing options
+	LiveUpdatesOf(threadID string, params ListingOptions) ([]*LiveUpdate, *SliceInfo, error)
+
+	// SubmissionByURL returns the submission behind the given permalink, short link or share link, along with its comment tree when comment options are given
+	SubmissionByURL(permalinkOrShareURL string, opts *CommentOptions) (*Submission, []*Comment, error)
+
+	// CommentsOf returns the comment tree of the given submission, considering comment sort and comment options
+	CommentsOf(submissionID string, sort CommentSort, params CommentOptions) ([]*Comment, error)
+
+	// MoreChildren retrieves the comments collapsed behind the given stub of the given submission
+	MoreChildren(submissionID string, sort CommentSort, more *MoreComments) ([]*Comment, error)
+
+	// ExpandAll replaces the collapsed comment stubs of the given tree with the comments they stand for, making at most maxRequests requests
+	ExpandAll(submissionID string, sort CommentSort, comments []*Comment, maxRequests int) ([]*Comment, error)
+
+	// CommentsForAll fetches the comment trees of the given submissions, several at once
+	CommentsForAll(submissionIDs []string, opts BulkCommentsOptions) (*BulkComments, error)
+
+	// CommentsBy returns the comments of the given author, considering popularity sort, age sort, and listing options
+	CommentsBy(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Comment, *SliceInfo, error)
+
+	// OverviewOf returns the submissions and comments of the given author, interleaved, considering popularity sort, age sort, and listing options
+	OverviewOf(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Thing, *SliceInfo, error)
+
+	// GildedOf returns the awarded submissions and comments of the given author, considering listing options
+	GildedOf(author string, params ListingOptions) ([]*Thing, *SliceInfo, error)
+
+	// HistoryOf returns the submissions and comments of the given author created within the given window, newest first
+	HistoryOf(author string, from time.Time, to time.Time) ([]*Thing, error)
+
+	// EstimateSubmissionCount estimates the no. of submissions made to the given subreddit within the given age
+	EstimateSubmissionCount(subreddit string, age AgeSort) (*SubmissionCountEstimate, error)
+
+	// SubredditAutocomplete returns up to limit subreddits whose name starts with the given query
+	SubredditAutocomplete(query string, limit int) ([]*SubredditSuggestion, error)
+
+	// RecommendedFor returns the names of subreddits related to the given ones, leaving out the omitted ones
+	RecommendedFor(subreddits []string, omit []string) ([]string, error)
+
+	// StylesheetOf returns the custom CSS and stylesheet images of the given subreddit
+	StylesheetOf(subreddit string) (*Stylesheet, error)
+
+	// SearchSubreddits returns the subreddits whose name or description matches the given query, considering listing options
+	SearchSubreddits(query string, params ListingOptions) ([]*Subreddit, *SliceInfo, error)
+
+	// PopularSubreddits returns the subreddits with the most activity, considering listing options
+	PopularSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error)
+
+	// NewSubreddits returns the most recently created subreddits, considering listing options
+	NewSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error)
+
+	// DefaultSubreddits returns the subreddits new users are subscribed to by default, considering listing options
+	DefaultSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error)
+
+	// TrendingSubreddits returns the names of the subreddits Reddit currently reports as trending
+	TrendingSubreddits() ([]string, error)
+
+	// GildedIn returns the awarded submissions and comments of the given subreddit, considering listing options
+	GildedIn(subreddit string, params ListingOptions) ([]*Thing, *SliceInfo, error)
+
+	// StickiesOf returns the submissions stickied to the given subreddit
+	StickiesOf(subreddit string) ([]*Submission, error)
+
+	// AboutSubreddit returns the details of the given subreddit
+	AboutSubreddit(name string) (*Subreddit, error)
+
+	// RulesOf returns the rules of the given subreddit
+	RulesOf(subreddit string) ([]*SubredditRule, error)
+
+	// ModeratorsOf returns the moderators of the given subreddit
+	ModeratorsOf(subreddit string) ([]*Moderator, error)
+
+	// LinkFlairsOf returns the link flair templates of the given subreddit
+	LinkFlairsOf(subreddit string) ([]*FlairTemplate, error)
+
+	// EmojisOf returns the custom emojis of the given subreddit along with the snoomojis available everywhere
+	EmojisOf(subreddit string) ([]*Emoji, error)
+
+	// SubmissionsByIDs returns the submissions with the given fullnames, or ids, in the given order
+	SubmissionsByIDs(fullnames []string) ([]*Submission, error)
+
+	// DuplicatesOf returns the other submissions of the link of the given submission, considering listing options
+	DuplicatesOf(submissionID string, params ListingOptions) ([]*Submission, *SliceInfo, error)
+
+	// Search returns the submissions matching the given query, site-wide or within a subreddit
+	Search(query string, opts SearchOptions) ([]*Submission, *SliceInfo, error)
+
+	// SearchByFlair returns the submissions to the given subreddit whose link flair matches the given text, considering listing options
+	SearchByFlair(subreddit string, flairText string, params ListingOptions) ([]*Submission, *SliceInfo, error)
+
+	// SubmissionsWithFlair returns the submissions to the given subreddit whose link flair matches the given text, considering age sort and listing options
+	SubmissionsWithFlair(subreddit string, flairText string, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error)
+
+	// AboutUser returns the account of the given user
+	AboutUser(username string) (*Account, error)
+
+	// TrophiesOf returns the trophies of the given user
+	TrophiesOf(username string) ([]*Trophy, error)
+
+	// ResolveAuthors looks up the accounts of the authors of the given submissions, see Submission.AuthorInfo
+	ResolveAuthors(submissions []*Submission) error
+
+	// CheckRedditStatus returns the overall status reported by Reddit's status page
+	CheckRedditStatus(ctx context.Context) (*RedditStatus, error)
 }
 
 // NewReadOnlyRedditClient creates a new session for those who want to log into a reddit account via OAuth.
 func NewReadOnlyRedditClient(clientID string, clientSecret string, userAgent string) (*ReadOnlyRedditClient, error) {
 
+	client, err := newClient(clientID, clientSecret, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.loginAuth(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// NewClientWithToken creates a new session reusing a token obtained elsewhere, e.g. by another process or client of
+// a pool, instead of requesting one. The credentials are used once the token expires.
+func NewClientWithToken(clientID string, clientSecret string, userAgent string, token *oauth2.Token) (*ReadOnlyRedditClient, error) {
+
+	if token == nil || len(token.AccessToken) == 0 {
+		return nil, errors.New("token must not be null, nor empty")
+	}
+
+	client, err := newClient(clientID, clientSecret, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	client.tokens = &tokenStore{token: copyToken(token)}
+	client.Token = client.tokens.token
+	client.grantType = ClientCredentialsGrant
+
+	return client, nil
+}
+
+// NewClientWithHTTPClient creates a new session sending its requests, the token request included, through the given
+// HTTP client, e.g. one with a proxy or a custom transport.
+func NewClientWithHTTPClient(clientID string, clientSecret string, userAgent string, httpClient *http.Client) (*ReadOnlyRedditClient, error) {
+
+	if httpClient == nil {
+		return nil, errors.New("httpClient must not be null")
+	}
+
+	client, err := newClient(clientID, clientSecret, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	client.httpClient = httpClient
+
+	if err := client.loginAuth(); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func newClient(clientID string, clientSecret string, userAgent string) (*ReadOnlyRedditClient, error) {
+
 	if len(clientID) == 0 {
 		return nil, errors.New("clientId must not be null, nor empty")
 	}
@@ -77,21 +311,87 @@ func NewReadOnlyRedditClient(clientID string, clientSecret string, userAgent str
 		return nil, errors.New("userAgent must not be null, nor empty")
 	}
 
-	client := &ReadOnlyRedditClient{
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadOnlyRedditClient{
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		userAgent:    userAgent,
+		httpClient:   &http.Client{Jar: jar},
+		sliceSizes:   &sliceSizes{},
+		authors:      &authorCache{},
+		rateLimits:   &rateLimitState{},
+	}, nil
+}
+
+// CurrentToken returns a copy of the token the client currently authorizes requests with, safe to share with other
+// clients, e.g. via NewClientWithToken, while this one keeps refreshing its own
+func (c *ReadOnlyRedditClient) CurrentToken() *oauth2.Token {
+
+	if c == nil {
+		return nil
 	}
 
-	if err := client.loginAuth(); err != nil {
+	return c.tokens.current()
+}
+
+// TokenSource returns a source of the tokens of the client, refreshed as needed, so the client plugs into the
+// oauth2 ecosystem, e.g. oauth2.NewClient
+func (c *ReadOnlyRedditClient) TokenSource() oauth2.TokenSource {
+	return clientTokenSource{client: c}
+}
+
+type clientTokenSource struct {
+	client *ReadOnlyRedditClient
+}
+
+// Token returns a copy of the current token of the client, refreshing it first if about to expire
+func (s clientTokenSource) Token() (*oauth2.Token, error) {
+
+	if s.client == nil || s.client.tokens == nil {
+		return nil, errors.New("client is not logged in, use NewReadOnlyRedditClient")
+	}
+
+	if _, err := s.client.accessToken(); err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	return s.client.CurrentToken(), nil
+}
+
+// tokenStore holds the token of a client, shared with the copies made by With and Consumer so that a refresh made
+// through any of them serves all. A nil value holds no token.
+type tokenStore struct {
+	mutex sync.Mutex
+	token *oauth2.Token
+}
+
+// current returns a copy of the token
+func (s *tokenStore) current() *oauth2.Token {
+	if s == nil {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.token == nil {
+		return nil
+	}
+	return copyToken(s.token)
+}
+
+// copyToken returns a copy of the token, keeping its extra fields, e.g. the scope
+func copyToken(token *oauth2.Token) *oauth2.Token {
+	copied := *token
+	return &copied
 }
 
 // Logger sets the logger. Optional, useful for debugging purposes.
-func (c *ReadOnlyRedditClient) Logger(logger *logrus.Logger) {
+func (c *ReadOnlyRedditClient) Logger(logger Logger) {
 	c.logger = logger
 }
 
@@ -100,23 +400,68 @@ func (c *ReadOnlyRedditClient) Throttle(interval time.Duration) {
 	if interval == 0 {
 		c.throttle = nil
 	} else {
-		c.throttle = rate.New(1, interval)
+		c.throttle = rate.NewLimiter(rate.Every(interval), 1)
 	}
 }
 
+// RateLimiter sets the limiter consulted before each HTTP request, e.g. one allowing bursts. Disable by setting it to nil.
+func (c *ReadOnlyRedditClient) RateLimiter(limiter Limiter) {
+	c.throttle = limiter
+}
+
+// Events sets the bus on which lifecycle events are published. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) Events(bus *EventBus) {
+	c.events = bus
+}
+
+// Quota sets the manager enforcing per-consumer request budgets. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) Quota(manager *QuotaManager) {
+	c.quota = manager
+}
+
+// WithDefaultListingOptions sets the listing options applied to every listing call, for the fields the call leaves unset.
+// Only Region, Limit and Show are defaulted; pagination anchors and Count always come from the call.
+func (c *ReadOnlyRedditClient) WithDefaultListingOptions(opts ListingOptions) {
+	c.listingDefaults = opts
+}
+
+// UserAgents sets the user agents API requests rotate through, one per request. Token requests keep the user agent
+// the client was created with, which stays bound to the credentials. Empty values are ignored; disable by passing nil.
+func (c *ReadOnlyRedditClient) UserAgents(userAgents []string) {
+	c.userAgents = newUserAgentRotation(userAgents)
+}
+
+// Consumer returns a client charging its requests to the given consumer of the quota manager.
+// The returned client shares the HTTP client, throttle, logger, events and quota manager of this one.
+func (c *ReadOnlyRedditClient) Consumer(name string) *ReadOnlyRedditClient {
+	consumer := *c
+	consumer.consumer = name
+	return &consumer
+}
+
 // AllSubmissionsTo returns a total no. of submissions to the given subreddit, considering popularity sort and age sort
 func (c *ReadOnlyRedditClient) AllSubmissionsTo(subreddit string, sort PopularitySort, age AgeSort, total int) ([]*Submission, error) {
-	return c.getAllSubmissions(subreddit, sort, age, total, c.SubmissionsTo)
+	return c.getAllSubmissions("r/"+subreddit, subreddit, sort, age, total, (*ReadOnlyRedditClient).SubmissionsTo)
 }
 
 // SubmissionsTo returns the submissions on the given subreddit, considering popularity sort, age sort, and listing options
+// The subreddit may also be all, optionally excluding subreddits as in all-a-b, popular, or a combination of
+// subreddits as in a+b+c, see CombinedSubreddits.
 func (c *ReadOnlyRedditClient) SubmissionsTo(subreddit string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error) {
 
-	if len(subreddit) == 0 {
-		return nil, nil, errors.New("subreddit cannot be null nor empty")
+	if err := validateSubreddit(subreddit); err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
 	}
 
-	queryParams, err := query.Values(params)
+	if err := age.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -155,21 +500,29 @@ func (c *ReadOnlyRedditClient) SubmissionsTo(subreddit string, sort PopularitySo
 
 // AllSubmissionsOf returns a total no. of submissions of the given author, considering popularity sort and age sort
 func (c *ReadOnlyRedditClient) AllSubmissionsOf(author string, sort PopularitySort, age AgeSort, total int) ([]*Submission, error) {
-	return c.getAllSubmissions(author, sort, age, total, c.SubmissionsOf)
+	return c.getAllSubmissions("u/"+author, author, sort, age, total, (*ReadOnlyRedditClient).SubmissionsOf)
 }
 
 // SubmissionsOf returns the submissions on the given author, considering popularity sort, age sort, and listing options
 func (c *ReadOnlyRedditClient) SubmissionsOf(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error) {
 
-	if len(author) == 0 {
-		return nil, nil, errors.New("author cannot be null nor empty")
+	if err := validateAuthor(author); err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := age.validate(); err != nil {
+		return nil, nil, err
 	}
 
 	if params.Limit > 100 && c.logger != nil {
 		c.logger.Debug("max limit is 100 results - should one need more, `after` or `before` for pagination")
 	}
 
-	queryParams, err := query.Values(params)
+	queryParams, err := c.listingValues(params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -209,156 +562,305 @@ func (c *ReadOnlyRedditClient) SubmissionsOf(author string, sort PopularitySort,
 	return submissions, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
 }
 
-func (c *ReadOnlyRedditClient) getAllSubmissions(subredditOrAuthor string, sort PopularitySort, age AgeSort, total int, fn func(string, PopularitySort, AgeSort, ListingOptions) ([]*Submission, *SliceInfo, error)) ([]*Submission, error) {
-	if total <= DefaultSliceSize {
-		submissions, _, err := fn(subredditOrAuthor, sort, age, ListingOptions{Limit: total})
-		if err != nil {
-			return nil, err
-		}
-		return submissions, nil
+// listingValues encodes the listing options, after applying the client defaults
+func (c *ReadOnlyRedditClient) listingValues(params ListingOptions) (url.Values, error) {
+
+	if c == nil {
+		return encodeValues(params)
+	}
+
+	if len(params.Region) == 0 {
+		params.Region = c.listingDefaults.Region
+	}
+	if params.Limit == 0 {
+		params.Limit = c.listingDefaults.Limit
+	}
+	if len(params.Show) == 0 {
+		params.Show = c.listingDefaults.Show
+	}
+
+	return encodeValues(params)
+}
+
+func (c *ReadOnlyRedditClient) getAllSubmissions(target string, subredditOrAuthor string, sort PopularitySort, age AgeSort, total int, fn func(*ReadOnlyRedditClient, string, PopularitySort, AgeSort, ListingOptions) ([]*Submission, *SliceInfo, error)) ([]*Submission, error) {
+
+	if err := validateTotal(total); err != nil {
+		return nil, err
 	}
 
-	var results []*Submission
+	if c == nil {
+		return nil, errors.New("client is not logged in, use NewReadOnlyRedditClient")
+	}
+
+	paged, pages := c.paged()
+	results := []*Submission{}
 	after := ""
+	var aborted error
+
+	for len(results) < total {
+		sliceSize := c.sliceSizes.get(target)
+		limit := total - len(results)
+		if limit > sliceSize {
+			limit = sliceSize
+		}
 
-	for {
-		submissions, slice, err := fn(subredditOrAuthor, sort, age, ListingOptions{
+		submissions, slice, err := fn(paged, subredditOrAuthor, sort, age, ListingOptions{
 			After: after,
-			Limit: DefaultSliceSize,
+			Limit: limit,
 		})
+		if err == nil {
+			err = pages.retrieved(slice)
+		}
 
+		if _, ok := err.(*PageHookError); ok || err == ErrStopPaging {
+			results = append(results, submissions...)
+			if ok {
+				aborted = err
+			}
+			break
+		}
 		if err != nil {
+			if isDecodeError(err) && sliceSize > MinSliceSize {
+				c.adaptSliceSize(target, sliceSize, err)
+				continue
+			}
 			return nil, err
 		}
 
-		for _, submission := range submissions {
-			results = append(results, submission)
-		}
+		results = append(results, submissions...)
 
-		if len(results) >= total || len(submissions) == 0 {
+		if len(submissions) == 0 || len(slice.After) == 0 {
 			break
 		}
 
 		after = slice.After
 	}
 
-	return results, nil
-}
+	if len(results) > total {
+		results = results[:total]
+	}
 
-func (c *ReadOnlyRedditClient) doGetRequest(url string, d interface{}) error {
+	return results, aborted
+}
 
-	if c.logger != nil {
-		c.logger.Debugf("doing GET to %s", url)
-	}
+func (c *ReadOnlyRedditClient) doGetRequest(url string, d interface{}) (err error) {
 
-	if c.throttle != nil {
-		if c.logger != nil {
-			c.logger.Debugf("must wait")
-		}
-		c.throttle.Wait()
+	if c == nil || c.httpClient == nil || c.tokens == nil {
+		return errors.New("client is not logged in, use NewReadOnlyRedditClient")
 	}
 
-	if c.Token.Expiry.Before(time.Now().Add(5 * time.Second)) {
-		if c.logger != nil {
-			c.logger.Debugf("token expired, must fetch a new one")
-		}
-		if err := c.refreshLoginAuth(); err != nil {
-			return err
-		}
-	}
+	ctx, cancel := c.requestContext()
+	defer cancel()
 
-	request, err := http.NewRequest("GET", url, nil)
+	request, userAgent, err := c.prepareRequest(ctx, "GET", url)
 	if err != nil {
 		return err
 	}
 
 	request.Header.Set("Accept", "*/*")
-	request.Header.Set("Accept-Encoding", "gzip, deflate")
-	request.Header.Set("Authorization", "bearer "+c.Token.AccessToken)
-	if c.Cookie != nil && len(c.Cookie.Name) > 0 && len(c.Cookie.Value) > 0 {
-		request.Header.Set("Cookie", c.Cookie.Name+":"+c.Cookie.Value)
-	}
+	request.Header.Set("Accept-Encoding", "gzip")
 	request.Header.Set("Connection", "keep-alive")
 	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	request.Header.Set("User-Agent", c.userAgent)
 
-	client := &http.Client{}
-	response, err := client.Do(request)
+	start := time.Now()
+	var response *http.Response
+	var responseBody []byte
+	var responseHash string
+	defer func() {
+		c.completed("GET", url, start, userAgent, response, len(responseBody), responseHash, err)
+	}()
+
+	response, err = c.httpClient.Do(request)
 	if err != nil {
 		return err
 	}
 	defer response.Body.Close()
 
-	if code := response.StatusCode; code < 200 || code > 299 {
-		return fmt.Errorf("cannot do get request, status: %v", response.Status)
+	c.rateLimits.update(response, time.Now())
+	c.warnRateLimit(url, time.Now())
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		reset, _ := strconv.ParseFloat(response.Header.Get("X-Ratelimit-Reset"), 64)
+		c.publish(RateLimited{At: time.Now(), URL: url, Reset: time.Duration(reset * float64(time.Second))})
 	}
 
-	contentType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
-	if err != nil {
-		return err
+	if code := response.StatusCode; code < 200 || code > 299 {
+		return &StatusError{URL: url, StatusCode: response.StatusCode, Status: response.Status}
 	}
 
-	if contentType != "application/json" {
-		return fmt.Errorf("unknown response content type: %s", contentType)
+	if err := checkJSONContentType(url, response.Header.Get("Content-Type")); err != nil {
+		return err
 	}
 
-	reader, err := gzip.NewReader(response.Body)
+	reader, err := decodedBody(response)
 	if err != nil {
 		return err
 	}
 	defer reader.Close()
 
-	responseBody, err := ioutil.ReadAll(io.LimitReader(reader, 1<<20))
+	// the body is decoded straight from a pooled buffer, json.Unmarshal keeping no reference to it
+	buffer := getBuffer()
+	defer putBuffer(buffer)
+
+	responseBody, responseHash, err = readLimited(url, reader, c.responseLimit(), buffer)
 	if err != nil {
-		return fmt.Errorf("cannot read body of response: %v", err)
+		return err
+	}
+
+	if err := c.store(url, start, responseBody, responseHash); err != nil {
+		return err
 	}
 
 	return json.Unmarshal(responseBody, d)
 }
 
+// completed records the request in the audit log and publishes its PageFetched event
+func (c *ReadOnlyRedditClient) completed(method string, url string, start time.Time, userAgent string, response *http.Response, bytes int, hash string, err error) {
+
+	statusCode := 0
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+
+	c.record(method, url, start, response, bytes, hash, err)
+	family, subreddit := c.endpointLabels(url)
+	c.publish(PageFetched{At: time.Now(), URL: url, Family: family, Subreddit: subreddit, UserAgent: userAgent, StatusCode: statusCode, Duration: time.Since(start), Err: err})
+}
+
+// prepareRequest runs the page hook, charges the quota and waits for the throttle and the rate limit, then builds an
+// authorized request to the gateway URL of the given Reddit URL. It returns the user agent the request carries.
+func (c *ReadOnlyRedditClient) prepareRequest(ctx context.Context, method string, url string) (*http.Request, string, error) {
+
+	if err := c.pages.requesting(url); err != nil {
+		return nil, "", err
+	}
+
+	if c.logger != nil {
+		c.logger.Debugf("doing %s to %s", method, url)
+	}
+
+	if c.quota != nil {
+		consumer := c.consumer
+		if len(consumer) == 0 {
+			consumer = DefaultConsumer
+		}
+		if err := c.quota.acquire(consumer); err != nil {
+			if quotaErr, ok := err.(*QuotaExceededError); ok {
+				c.publish(QuotaExceeded{At: time.Now(), Consumer: consumer, Budget: quotaErr.Budget})
+			}
+			return nil, "", err
+		}
+	}
+
+	if c.throttle != nil {
+		if c.logger != nil {
+			c.logger.Debugf("must wait")
+		}
+		if err := c.throttle.Wait(ctx); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := c.slowDown(ctx); err != nil {
+		return nil, "", err
+	}
+
+	accessToken, err := c.accessToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	request, err := http.NewRequest(method, c.gatewayURL(url), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	request = request.WithContext(ctx)
+
+	request.Header.Set("Authorization", "bearer "+accessToken)
+	if len(c.acceptLanguage) > 0 {
+		request.Header.Set("Accept-Language", c.acceptLanguage)
+	}
+	userAgent := c.userAgents.next(c.userAgent)
+	request.Header.Set("User-Agent", userAgent)
+	if c.logger != nil {
+		c.logger.Debugf("using user agent %s", userAgent)
+	}
+
+	return request, userAgent, nil
+}
+
 func (c *ReadOnlyRedditClient) loginAuth() error {
 
-	token, cookie, err := c.retrieveTokenAndCookie(url.Values{
-		"grant_type": {"client_credentials"},
+	token, err := c.retrieveToken(url.Values{
+		"grant_type": {ClientCredentialsGrant},
 	})
 
 	if err != nil {
 		return err
 	}
 
+	c.tokens = &tokenStore{token: token}
 	c.Token = token
-	c.Cookie = cookie
+	c.grantType = ClientCredentialsGrant
 
 	return nil
 }
 
-func (c *ReadOnlyRedditClient) refreshLoginAuth() error {
+// accessToken returns the access token to authorize a request with, refreshing it first if about to expire.
+// Concurrent requests, made through the client or any of its copies, wait for a single refresh.
+func (c *ReadOnlyRedditClient) accessToken() (string, error) {
 
-	if len(c.Token.RefreshToken) == 0 {
-		return errors.New("oauth2: token expired and refresh token is not set")
+	if c.tokens == nil {
+		return "", errors.New("client is not logged in, use NewReadOnlyRedditClient")
 	}
 
-	token, cookie, err := c.retrieveTokenAndCookie(url.Values{
-		"grant_type":    {"refresh_token"},
-		"refresh_token": {c.Token.RefreshToken},
-	})
+	c.tokens.mutex.Lock()
+	defer c.tokens.mutex.Unlock()
 
-	if err != nil {
-		return err
+	if c.tokens.token == nil {
+		return "", errors.New("client is not logged in, use NewReadOnlyRedditClient")
 	}
 
-	c.Token = token
-	c.Cookie = cookie
+	if c.tokens.token.Expiry.Before(time.Now().Add(5 * time.Second)) {
+		if c.logger != nil {
+			c.logger.Debugf("token expired, must fetch a new one")
+		}
+		token, err := c.refreshToken(c.tokens.token)
+		if err != nil {
+			return "", err
+		}
+		// in place, keeping the deprecated Token field of the client and its copies in sync
+		*c.tokens.token = *token
+	}
 
-	return nil
+	return c.tokens.token.AccessToken, nil
 }
 
-func (c *ReadOnlyRedditClient) retrieveTokenAndCookie(values url.Values) (*oauth2.Token, *http.Cookie, error) {
+// refreshToken returns a token replacing the given, expired, one
+func (c *ReadOnlyRedditClient) refreshToken(expired *oauth2.Token) (*oauth2.Token, error) {
+
+	if len(expired.RefreshToken) == 0 {
+		// application only tokens come without refresh token, a new one is requested instead
+		if c.grantType == ClientCredentialsGrant {
+			return c.retrieveToken(url.Values{
+				"grant_type": {ClientCredentialsGrant},
+			})
+		}
+		return nil, errors.New("oauth2: token expired and refresh token is not set")
+	}
+
+	return c.retrieveToken(url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {expired.RefreshToken},
+	})
+}
+
+func (c *ReadOnlyRedditClient) retrieveToken(values url.Values) (token *oauth2.Token, err error) {
 
 	requestBody := strings.NewReader(values.Encode())
-	request, err := http.NewRequest("POST", TokenURL, requestBody)
+	request, err := http.NewRequest("POST", c.gatewayURL(TokenURL), requestBody)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	request.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
@@ -367,62 +869,64 @@ func (c *ReadOnlyRedditClient) retrieveTokenAndCookie(values url.Values) (*oauth
 	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	request.Header.Set("User-Agent", c.userAgent)
 
-	client := &http.Client{}
-	response, err := client.Do(request)
+	start := time.Now()
+	var response *http.Response
+	var responseBody []byte
+	defer func() {
+		c.record("POST", TokenURL, start, response, len(responseBody), "", err)
+	}()
+
+	response, err = c.httpClient.Do(request)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer response.Body.Close()
 
 	if code := response.StatusCode; code < 200 || code > 299 {
-		return nil, nil, fmt.Errorf("oauth2: cannot fetch token, status: %v", response.Status)
-	}
-
-	contentType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
-	if err != nil {
-		return nil, nil, err
+		return nil, fmt.Errorf("oauth2: cannot fetch token, status: %v", response.Status)
 	}
 
-	if contentType != "application/json" {
-		return nil, nil, fmt.Errorf("unknown response content type: %s", contentType)
+	if err := checkJSONContentType(TokenURL, response.Header.Get("Content-Type")); err != nil {
+		return nil, err
 	}
 
-	responseBody, err := ioutil.ReadAll(io.LimitReader(response.Body, 1<<20))
+	responseBody, err = ioutil.ReadAll(io.LimitReader(response.Body, 1<<20))
 	if err != nil {
-		return nil, nil, fmt.Errorf("oauth2: cannot read body of response: %v", err)
+		return nil, fmt.Errorf("oauth2: cannot read body of response: %v", err)
 	}
 
 	var tokenAsJSON TokenAsJSON
 	if err = json.Unmarshal(responseBody, &tokenAsJSON); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	token := &oauth2.Token{
+	token = &oauth2.Token{
 		AccessToken:  tokenAsJSON.AccessToken,
 		TokenType:    tokenAsJSON.TokenType,
 		RefreshToken: tokenAsJSON.RefreshToken,
 		Expiry:       time.Now().Add(time.Duration(tokenAsJSON.ExpiresIn) * time.Second),
 	}
+	token = token.WithExtra(map[string]interface{}{"scope": tokenAsJSON.Scope})
 
 	if len(token.RefreshToken) == 0 {
 		token.RefreshToken = values.Get("refresh_token")
 	}
 
 	if len(token.AccessToken) == 0 {
-		return token, nil, errors.New("oauth2: server response missing access_token")
+		return token, errors.New("oauth2: server response missing access_token")
 	}
 
 	if c.logger != nil {
 		c.logger.Debugf("got %s access token expiring at %v", token.TokenType, token.Expiry)
 	}
 
-	var correctCookie *http.Cookie
-	cookies := response.Cookies()
-	for _, cookie := range cookies {
-		if cookie.Name == "edgebucket" {
-			correctCookie = cookie
-			break
-		}
+	c.publish(TokenRefreshed{At: time.Now(), TokenType: token.TokenType, Expiry: token.Expiry})
+
+	return token, nil
+}
+
+func (c *ReadOnlyRedditClient) publish(event Event) {
+	if c.events != nil {
+		c.events.Publish(event)
 	}
-	return token, correctCookie, nil
 }
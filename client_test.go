@@ -0,0 +1,270 @@
+package redditreadgo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// requestMethods calls every method of the client building a request, each with valid input
+func requestMethods(c *ReadOnlyRedditClient) map[string]func() error {
+
+	listing := ListingOptions{Limit: 5}
+	more := &MoreComments{Children: []string{"c1"}}
+
+	return map[string]func() error{
+		"AllSubmissionsTo": func() error { _, err := c.AllSubmissionsTo("golang", NewSubmissions, AllTime, 10); return err },
+		"SubmissionsTo":    func() error { _, _, err := c.SubmissionsTo("golang", NewSubmissions, AllTime, listing); return err },
+		"AllSubmissionsOf": func() error { _, err := c.AllSubmissionsOf("spez", NewSubmissions, AllTime, 10); return err },
+		"SubmissionsOf":    func() error { _, _, err := c.SubmissionsOf("spez", NewSubmissions, AllTime, listing); return err },
+		"Submission":       func() error { _, err := c.Submission("abc"); return err },
+		"SubmissionWithComments": func() error {
+			_, _, err := c.SubmissionWithComments("abc", CommentOptions{})
+			return err
+		},
+		"MultisOf": func() error { _, err := c.MultisOf("spez"); return err },
+		"SubmissionsToMulti": func() error {
+			_, _, err := c.SubmissionsToMulti("spez", "news", NewSubmissions, AllTime, listing)
+			return err
+		},
+		"LiveThread":    func() error { _, err := c.LiveThread("abc123"); return err },
+		"LiveUpdatesOf": func() error { _, _, err := c.LiveUpdatesOf("abc123", listing); return err },
+		"SubmissionByURL": func() error {
+			_, _, err := c.SubmissionByURL("https://redd.it/abc", nil)
+			return err
+		},
+		"SubmissionByShareURL": func() error {
+			_, _, err := c.SubmissionByURL("https://www.reddit.com/r/golang/s/abc", nil)
+			return err
+		},
+		"CommentsOf":   func() error { _, err := c.CommentsOf("abc", TopComments, CommentOptions{}); return err },
+		"MoreChildren": func() error { _, err := c.MoreChildren("abc", TopComments, more); return err },
+		"ExpandAll": func() error {
+			_, err := c.ExpandAll("abc", TopComments, []*Comment{{More: more}}, 1)
+			return err
+		},
+		"CommentsForAll": func() error {
+			bulk, err := c.CommentsForAll([]string{"abc"}, BulkCommentsOptions{})
+			if err == nil {
+				err = bulk.Errors["abc"]
+			}
+			return err
+		},
+		"CommentsBy": func() error { _, _, err := c.CommentsBy("spez", NewSubmissions, AllTime, listing); return err },
+		"OverviewOf": func() error { _, _, err := c.OverviewOf("spez", NewSubmissions, AllTime, listing); return err },
+		"GildedOf":   func() error { _, _, err := c.GildedOf("spez", listing); return err },
+		"HistoryOf": func() error {
+			_, err := c.HistoryOf("spez", time.Now().Add(-time.Hour), time.Time{})
+			return err
+		},
+		"EstimateSubmissionCount": func() error { _, err := c.EstimateSubmissionCount("golang", ThisDay); return err },
+		"SubredditAutocomplete":   func() error { _, err := c.SubredditAutocomplete("go", 5); return err },
+		"RecommendedFor":          func() error { _, err := c.RecommendedFor([]string{"golang"}, nil); return err },
+		"StylesheetOf":            func() error { _, err := c.StylesheetOf("golang"); return err },
+		"SearchSubreddits":        func() error { _, _, err := c.SearchSubreddits("go", listing); return err },
+		"PopularSubreddits":       func() error { _, _, err := c.PopularSubreddits(listing); return err },
+		"NewSubreddits":           func() error { _, _, err := c.NewSubreddits(listing); return err },
+		"DefaultSubreddits":       func() error { _, _, err := c.DefaultSubreddits(listing); return err },
+		"TrendingSubreddits":      func() error { _, err := c.TrendingSubreddits(); return err },
+		"GildedIn":                func() error { _, _, err := c.GildedIn("golang", listing); return err },
+		"StickiesOf":              func() error { _, err := c.StickiesOf("golang"); return err },
+		"AboutSubreddit":          func() error { _, err := c.AboutSubreddit("golang"); return err },
+		"RulesOf":                 func() error { _, err := c.RulesOf("golang"); return err },
+		"ModeratorsOf":            func() error { _, err := c.ModeratorsOf("golang"); return err },
+		"LinkFlairsOf":            func() error { _, err := c.LinkFlairsOf("golang"); return err },
+		"EmojisOf":                func() error { _, err := c.EmojisOf("golang"); return err },
+		"SubmissionsByIDs":        func() error { _, err := c.SubmissionsByIDs([]string{"t3_abc"}); return err },
+		"DuplicatesOf":            func() error { _, _, err := c.DuplicatesOf("abc", listing); return err },
+		"Search":                  func() error { _, _, err := c.Search("gopher", SearchOptions{Listing: listing}); return err },
+		"SearchByFlair":           func() error { _, _, err := c.SearchByFlair("golang", "news", listing); return err },
+		"SubmissionsWithFlair": func() error {
+			_, _, err := c.SubmissionsWithFlair("golang", "news", ThisWeek, listing)
+			return err
+		},
+		"AboutUser":      func() error { _, err := c.AboutUser("spez"); return err },
+		"TrophiesOf":     func() error { _, err := c.TrophiesOf("spez"); return err },
+		"ResolveAuthors": func() error { return c.ResolveAuthors([]*Submission{{Author: "spez"}}) },
+		"TokenSource":    func() error { _, err := c.TokenSource().Token(); return err },
+	}
+}
+
+func TestNoPanicWithoutLogin(t *testing.T) {
+
+	clients := map[string]*ReadOnlyRedditClient{
+		"nil":        nil,
+		"zero value": {},
+	}
+
+	for clientName, client := range clients {
+		for methodName, method := range requestMethods(client) {
+			t.Run(clientName+"/"+methodName, func(t *testing.T) {
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						t.Fatalf("panicked: %v", recovered)
+					}
+				}()
+
+				if err := method(); err == nil {
+					t.Error("expected an error from a client that is not logged in")
+				}
+			})
+		}
+	}
+}
+
+func TestNoPanicReportingWithoutLogin(t *testing.T) {
+
+	for clientName, client := range map[string]*ReadOnlyRedditClient{"nil": nil, "zero value": {}} {
+		t.Run(clientName, func(t *testing.T) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					t.Fatalf("panicked: %v", recovered)
+				}
+			}()
+
+			if token := client.CurrentToken(); token != nil {
+				t.Errorf("expected no token, got %v", token)
+			}
+			if capabilities := client.Capabilities(); len(capabilities.Families) > 0 {
+				t.Errorf("expected no capabilities, got %v", capabilities.Families)
+			}
+			if _, err := client.Plan(10); err != nil {
+				t.Errorf("unexpected error planning: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckRedditStatusNilClient(t *testing.T) {
+
+	var client *ReadOnlyRedditClient
+	if _, err := client.CheckRedditStatus(context.Background()); err == nil {
+		t.Error("expected an error from a nil client")
+	}
+}
+
+func TestZeroValueClientConfiguration(t *testing.T) {
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			t.Fatalf("panicked: %v", recovered)
+		}
+	}()
+
+	client := &ReadOnlyRedditClient{}
+	client.Logger(nil)
+	client.Throttle(time.Millisecond)
+	client.RateLimiter(nil)
+	client.Events(nil)
+	client.Quota(nil)
+	client.WithDefaultListingOptions(ListingOptions{Limit: 10})
+	client.UserAgents([]string{"a", "b"})
+	client.Audit(nil)
+	client.MaxResponseBytes(0)
+	client.WithRawArchive(nil)
+	client.SubredditLabels(10)
+	client.RateLimitWarning(10, true)
+	client.BeforePage(nil)
+	client.AfterPage(nil)
+	if err := client.Gateway(GatewayOptions{QueryHost: "proxy.example.com"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err == nil {
+		t.Error("expected an error from a client that is not logged in")
+	}
+}
+
+func TestNewClientWithHTTPClient(t *testing.T) {
+
+	if _, err := NewClientWithHTTPClient("id", "secret", "redditreadgo-test/1.0", nil); err == nil {
+		t.Error("expected an error for a nil HTTP client")
+	}
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": `{"kind":"Listing","data":{"children":[]}}`}}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	var hosts []string
+	transport := server.Client().Transport
+	httpClient := &http.Client{Transport: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		hosts = append(hosts, request.URL.Host)
+		redirected := new(http.Request)
+		*redirected = *request
+		location := *request.URL
+		location.Host = strings.TrimPrefix(server.URL, "https://")
+		redirected.URL = &location
+		return transport.RoundTrip(redirected)
+	})}
+
+	client, err := NewClientWithHTTPClient("id", "secret", "redditreadgo-test/1.0", httpClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"www.reddit.com", "oauth.reddit.com"}
+	if strings.Join(hosts, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected requests to %v through the given client, got %v", expected, hosts)
+	}
+}
+
+func TestNewClientWithToken(t *testing.T) {
+
+	if _, err := NewClientWithToken("id", "secret", "redditreadgo-test/1.0", nil); err == nil {
+		t.Error("expected an error for a nil token")
+	}
+	if _, err := NewClientWithToken("id", "secret", "redditreadgo-test/1.0", &oauth2.Token{}); err == nil {
+		t.Error("expected an error for an empty token")
+	}
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/user/spez/about": `{"kind":"t2","data":{"name":"spez"}}`}}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	clientWith := func(token *oauth2.Token) *ReadOnlyRedditClient {
+		client, err := NewClientWithToken("id", "secret", "redditreadgo-test/1.0", token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		client.httpClient = server.Client()
+		if err := client.Gateway(GatewayOptions{QueryHost: host, TokenHost: host}); err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+
+	shared := &oauth2.Token{AccessToken: "token-shared", TokenType: "bearer", Expiry: time.Now().Add(time.Minute)}
+	client := clientWith(shared)
+	if _, err := client.AboutUser("spez"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorization := fake.received()[0].Header.Get("Authorization"); authorization != "bearer token-shared" {
+		t.Errorf("expected the given token to be used, got %q", authorization)
+	}
+	if tokens := atomic.LoadInt64(&fake.tokens); tokens != 0 {
+		t.Errorf("expected no token request while the given token is valid, got %d", tokens)
+	}
+
+	// once about to expire, the token is replaced using the credentials, leaving the given one untouched
+	expiring := &oauth2.Token{AccessToken: "token-expiring", TokenType: "bearer", Expiry: time.Now()}
+	client = clientWith(expiring)
+	if _, err := client.AboutUser("spez"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorization := fake.received()[1].Header.Get("Authorization"); authorization != "bearer token-1" {
+		t.Errorf("expected the refreshed token to be used, got %q", authorization)
+	}
+	if current := client.CurrentToken(); current.AccessToken != "token-1" || expiring.AccessToken != "token-expiring" {
+		t.Errorf("expected the client alone to hold the refreshed token, got %s and %s", current.AccessToken, expiring.AccessToken)
+	}
+}
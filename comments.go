@@ -0,0 +1,239 @@
+package redditreadgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var submissionIDPattern = regexp.MustCompile(`^[a-z0-9]{1,13}$`)
+
+// thing represents a generic Reddit object before its data is decoded according to its kind
+type thing struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// listing represents a generic Reddit listing
+type listing struct {
+	Kind string `json:"kind"`
+	Data struct {
+		Dist     int     `json:"dist"`
+		Children []thing `json:"children"`
+		After    string  `json:"after"`
+		Before   string  `json:"before"`
+	} `json:"data"`
+}
+
+// IsMore reports whether the comment is a stub standing for collapsed comments, described by More
+func (c *Comment) IsMore() bool {
+	return c.More != nil
+}
+
+// UnmarshalJSON decodes a comment along with its nested replies, normalizing the fields whose JSON type differs
+// between Reddit endpoints
+func (c *Comment) UnmarshalJSON(data []byte) error {
+
+	type comment Comment
+	aux := struct {
+		*comment
+		Likes   json.RawMessage `json:"likes"`
+		Edited  json.RawMessage `json:"edited"`
+		Replies json.RawMessage `json:"replies"`
+	}{comment: (*comment)(c)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if c.Likes, err = normalizeLikes(aux.Likes); err != nil {
+		return err
+	}
+	if c.Edited, c.EditedUTC, err = normalizeEdited(aux.Edited); err != nil {
+		return err
+	}
+
+	// replies is an empty string rather than an empty listing when there are none
+	if isNull(aux.Replies) || bytes.Equal(bytes.TrimSpace(aux.Replies), []byte(`""`)) {
+		c.Replies = nil
+		return nil
+	}
+
+	var replies listing
+	if err := json.Unmarshal(aux.Replies, &replies); err != nil {
+		return err
+	}
+
+	c.Replies, err = decodeComments(replies.Data.Children)
+	return err
+}
+
+// CommentsOf returns the comment tree of the given submission, considering comment sort and comment options
+func (c *ReadOnlyRedditClient) CommentsOf(submissionID string, sort CommentSort, params CommentOptions) ([]*Comment, error) {
+	_, comments, err := c.submissionWithComments(submissionID, sort, params)
+	return comments, err
+}
+
+// Submission returns the submission with the given id, or fullname
+func (c *ReadOnlyRedditClient) Submission(id string) (*Submission, error) {
+
+	submissions, err := c.SubmissionsByIDs([]string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(submissions) == 0 {
+		return nil, fmt.Errorf("no submission returned for %s", id)
+	}
+
+	return submissions[0], nil
+}
+
+// SubmissionWithComments returns the submission with the given id, or fullname, along with its comment tree,
+// considering comment options
+func (c *ReadOnlyRedditClient) SubmissionWithComments(id string, opts CommentOptions) (*Submission, []*Comment, error) {
+	return c.submissionWithComments(id, DefaultCommentSort, opts)
+}
+
+func (c *ReadOnlyRedditClient) submissionWithComments(submissionID string, sort CommentSort, params CommentOptions) (*Submission, []*Comment, error) {
+
+	id, err := normalizeSubmissionID(submissionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := encodeValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(sort) > 0 {
+		queryParams.Set("sort", string(sort))
+	}
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/comments/%s?%v", QueryURL, id, queryParams.Encode())
+
+	var response []listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	if len(response) != 2 {
+		return nil, nil, fmt.Errorf("unexpected comments response, got %d listings instead of 2", len(response))
+	}
+
+	submissions, err := decodeSubmissions(response[0].Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(submissions) == 0 {
+		return nil, nil, fmt.Errorf("no submission returned for %s", submissionID)
+	}
+
+	comments, err := decodeComments(response[1].Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submissions[0], comments, nil
+}
+
+// CommentsBy returns the comments of the given author, considering popularity sort, age sort, and listing options
+func (c *ReadOnlyRedditClient) CommentsBy(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Comment, *SliceInfo, error) {
+
+	if err := validateAuthor(author); err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := age.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if params.Limit > 100 && c.logger != nil {
+		c.logger.Debug("max limit is 100 results - should one need more, `after` or `before` for pagination")
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(sort) > 0 {
+		queryParams.Set("sort", string(sort))
+	}
+	queryParams.Set("t", string(age))
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/user/%s/comments?%v", QueryURL, author, queryParams.Encode())
+
+	var response listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	comments, err := decodeComments(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return comments, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// decodeComments decodes comments and collapsed comment stubs, skipping any other kind
+func decodeComments(children []thing) ([]*Comment, error) {
+
+	comments := make([]*Comment, 0, len(children))
+	for _, child := range children {
+		switch child.Kind {
+		case "t1":
+			comment := new(Comment)
+			if err := json.Unmarshal(child.Data, comment); err != nil {
+				return nil, err
+			}
+			comments = append(comments, comment)
+		case "more":
+			more := new(MoreComments)
+			if err := json.Unmarshal(child.Data, more); err != nil {
+				return nil, err
+			}
+			comments = append(comments, &Comment{
+				ID:       more.ID,
+				Name:     more.Name,
+				ParentID: more.ParentID,
+				Depth:    more.Depth,
+				More:     more,
+			})
+		}
+	}
+
+	return comments, nil
+}
+
+// normalizeSubmissionID validates a submission id, given with or without its t3_ prefix, and returns it without
+func normalizeSubmissionID(submissionID string) (string, error) {
+
+	if len(submissionID) == 0 {
+		return "", errors.New("submissionID cannot be null nor empty")
+	}
+
+	id := strings.TrimPrefix(submissionID, "t3_")
+	if !submissionIDPattern.MatchString(id) {
+		return "", fmt.Errorf("invalid submission id: %q", submissionID)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,138 @@
+package redditreadgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UnmarshalJSON decodes a submission, normalizing the fields whose JSON type differs between Reddit endpoints
+// (OAuth vs public JSON, new vs old Reddit): likes is a boolean or null, edited is false or the edit timestamp,
+// and the category and report fields are either strings, arrays or null.
+func (s *Submission) UnmarshalJSON(data []byte) error {
+
+	type submission Submission
+	aux := struct {
+		*submission
+		Likes             json.RawMessage `json:"likes"`
+		Edited            json.RawMessage `json:"edited"`
+		ContentCategories json.RawMessage `json:"content_categories"`
+		PostCategories    json.RawMessage `json:"post_categories"`
+		ReportReasons     json.RawMessage `json:"report_reasons"`
+	}{submission: (*submission)(s)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if s.Likes, err = normalizeLikes(aux.Likes); err != nil {
+		return err
+	}
+	if s.Edited, s.EditedUTC, err = normalizeEdited(aux.Edited); err != nil {
+		return err
+	}
+	if s.ContentCategories, err = normalizeStrings(aux.ContentCategories); err != nil {
+		return err
+	}
+	if s.PostCategories, err = normalizeStrings(aux.PostCategories); err != nil {
+		return err
+	}
+	if s.ReportReasons, err = normalizeStrings(aux.ReportReasons); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func isNull(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
+
+// normalizeLikes returns "true", "false" or "" for an up vote, a down vote or no vote
+func normalizeLikes(raw json.RawMessage) (string, error) {
+	if isNull(raw) {
+		return "", nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string:
+		return v, nil
+	}
+	return "", fmt.Errorf("cannot normalize likes: %s", string(raw))
+}
+
+// normalizeEdited returns whether the item was edited and, when known, the edit timestamp
+func normalizeEdited(raw json.RawMessage) (bool, float64, error) {
+	if isNull(raw) {
+		return false, 0, nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false, 0, err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return v, 0, nil
+	case float64:
+		return v > 0, v, nil
+	}
+	return false, 0, fmt.Errorf("cannot normalize edited: %s", string(raw))
+}
+
+// normalizeStrings returns a string, or the comma-separated elements of an array of strings
+func normalizeStrings(raw json.RawMessage) (string, error) {
+	if isNull(raw) {
+		return "", nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			values = append(values, fmt.Sprint(element))
+		}
+		return strings.Join(values, ","), nil
+	}
+	return "", fmt.Errorf("cannot normalize %s as a string", string(raw))
+}
+
+// UnmarshalJSON decodes a subreddit, accepting both the over18 flag of its about page and the over_18 flag of the
+// summary Reddit embeds into listings as sr_detail
+func (s *Subreddit) UnmarshalJSON(data []byte) error {
+
+	type subreddit Subreddit
+	aux := struct {
+		*subreddit
+		Over18Detail *bool `json:"over_18"`
+	}{subreddit: (*subreddit)(s)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Over18Detail != nil {
+		s.Over18 = *aux.Over18Detail
+	}
+
+	return nil
+}
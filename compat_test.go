@@ -0,0 +1,91 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSubredditOver18(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		document string
+		expected bool
+	}{
+		{"about page", `{"display_name":"golang","over18":true}`, true},
+		{"listing detail", `{"display_name":"golang","over_18":true}`, true},
+		{"listing detail not over 18", `{"display_name":"golang","over18":true,"over_18":false}`, false},
+		{"neither", `{"display_name":"golang"}`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subreddit := new(Subreddit)
+			if err := json.Unmarshal([]byte(test.document), subreddit); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if subreddit.DisplayName != "golang" || subreddit.Over18 != test.expected {
+				t.Errorf("expected golang over 18 %v, got %s over 18 %v", test.expected, subreddit.DisplayName, subreddit.Over18)
+			}
+		})
+	}
+}
+
+func TestSubmissionSubredditDetail(t *testing.T) {
+
+	document := `{"id":"abc","subreddit":"golang","sr_detail":{"display_name":"golang","title":"The Go Programming Language",` +
+		`"submit_text_label":"Ask","submit_link_label":"Share","over_18":false,"subscribers":250000}}`
+
+	submission := new(Submission)
+	if err := json.Unmarshal([]byte(document), submission); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	detail := submission.SubredditDetail
+	if detail == nil {
+		t.Fatal("expected the subreddit detail to be decoded")
+	}
+	if detail.DisplayName != "golang" || detail.SubmitTextLabel != "Ask" || detail.SubmitLinkLabel != "Share" || detail.Subscribers != 250000 {
+		t.Errorf("unexpected subreddit detail %+v", detail)
+	}
+}
+
+func TestListingSubredditDetail(t *testing.T) {
+
+	page := `{"kind":"Listing","data":{"children":[` +
+		`{"kind":"t3","data":{"id":"s1","subreddit":"golang","sr_detail":{"display_name":"golang","over_18":false}}},` +
+		`{"kind":"t3","data":{"id":"s2","subreddit":"rust","sr_detail":{"display_name":"rust","over_18":false}}}]}}`
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{
+		"/r/golang+rust/new":   page,
+		"/user/spez/submitted": page,
+	}}
+	client := newTestClient(t, fake)
+	params := ListingOptions{IncludeSubredditDetail: true}
+
+	requests := map[string]func() ([]*Submission, *SliceInfo, error){
+		"SubmissionsTo": func() ([]*Submission, *SliceInfo, error) {
+			return client.SubmissionsTo("golang+rust", NewSubmissions, AllTime, params)
+		},
+		"SubmissionsOf": func() ([]*Submission, *SliceInfo, error) {
+			return client.SubmissionsOf("spez", NewSubmissions, AllTime, params)
+		},
+	}
+
+	for name, request := range requests {
+		t.Run(name, func(t *testing.T) {
+			submissions, _, err := request()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(submissions) != 2 {
+				t.Fatalf("expected 2 submissions, got %d", len(submissions))
+			}
+			for _, submission := range submissions {
+				if submission.SubredditDetail == nil || submission.SubredditDetail.DisplayName != submission.Subreddit {
+					t.Errorf("expected the detail of %s, got %+v", submission.Subreddit, submission.SubredditDetail)
+				}
+			}
+		})
+	}
+}
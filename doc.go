@@ -0,0 +1,9 @@
+// Package redditreadgo provides an efficient, read-only client for retrieving Reddit submissions of a subreddit or
+// of a redditor, authenticating via OAuth with the application-only grant.
+//
+// Input handling contract: every method building a request validates its arguments before any network call and
+// reports invalid input (empty or malformed subreddit and author names, unknown sorts, negative or excessive limits
+// and totals, conflicting pagination anchors) by returning an error. No exported method panics on hostile or
+// zero-value input, including requests made through a nil or zero-value ReadOnlyRedditClient, which fail with an
+// error. The contract is checked by the fuzz tests of the package.
+package redditreadgo
@@ -0,0 +1,41 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DuplicatesOf returns the other submissions of the link of the given submission, i.e. its crossposts and
+// re-submissions, considering listing options
+func (c *ReadOnlyRedditClient) DuplicatesOf(submissionID string, params ListingOptions) ([]*Submission, *SliceInfo, error) {
+
+	id, err := normalizeSubmissionID(submissionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/duplicates/%s?%v", QueryURL, id, queryParams.Encode())
+
+	var response []listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	if len(response) != 2 {
+		return nil, nil, fmt.Errorf("unexpected duplicates response, got %d listings instead of 2", len(response))
+	}
+
+	submissions, err := decodeSubmissions(response[1].Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submissions, &SliceInfo{Before: response[1].Data.Before, After: response[1].Data.After}, nil
+}
@@ -0,0 +1,86 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxEstimationSlices specifies the maximum no. of listing slices retrieved when estimating a submission count
+const MaxEstimationSlices = 10
+
+// EstimateSubmissionCount estimates the no. of submissions made to the given subreddit within the given age.
+// The newest submissions are sampled until the period is covered or MaxEstimationSlices is reached, in which case
+// the count is extrapolated from the posting rate observed in the sample.
+func (c *ReadOnlyRedditClient) EstimateSubmissionCount(subreddit string, age AgeSort) (*SubmissionCountEstimate, error) {
+
+	period, err := age.duration()
+	if err != nil {
+		return nil, err
+	}
+
+	since := float64(time.Now().Add(-period).Unix())
+	estimate := &SubmissionCountEstimate{}
+
+	var oldest float64
+	after := ""
+
+	for estimate.Requests < MaxEstimationSlices {
+		submissions, slice, err := c.SubmissionsTo(subreddit, NewSubmissions, "", ListingOptions{
+			After: after,
+			Limit: DefaultSliceSize,
+		})
+		estimate.Requests++
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, submission := range submissions {
+			if submission.Stickied {
+				continue
+			}
+
+			if submission.CreatedUTC < since {
+				estimate.Count = estimate.Sampled
+				estimate.Exact = true
+				return estimate, nil
+			}
+
+			oldest = submission.CreatedUTC
+			estimate.Sampled++
+		}
+
+		if len(submissions) == 0 || len(slice.After) == 0 {
+			estimate.Count = estimate.Sampled
+			estimate.Exact = true
+			return estimate, nil
+		}
+
+		after = slice.After
+	}
+
+	covered := float64(time.Now().Unix()) - oldest
+	if covered <= 0 {
+		estimate.Count = estimate.Sampled
+		return estimate, nil
+	}
+
+	estimate.Count = int(float64(estimate.Sampled) * period.Seconds() / covered)
+	return estimate, nil
+}
+
+func (a AgeSort) duration() (time.Duration, error) {
+	switch a {
+	case ThisHour:
+		return time.Hour, nil
+	case ThisDay:
+		return 24 * time.Hour, nil
+	case ThisWeek:
+		return 7 * 24 * time.Hour, nil
+	case ThisMonth:
+		return 30 * 24 * time.Hour, nil
+	case ThisYear:
+		return 365 * 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("cannot estimate submissions for age sort %q, a bounded period is required", string(a))
+}
@@ -0,0 +1,95 @@
+package redditreadgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Event represents a typed lifecycle event emitted by the client
+type Event interface {
+	// Time returns the moment the event occurred
+	Time() time.Time
+}
+
+// TokenRefreshed is emitted every time a new access token is obtained
+type TokenRefreshed struct {
+	At        time.Time
+	TokenType string
+	Expiry    time.Time
+}
+
+// Time returns the moment the event occurred
+func (e TokenRefreshed) Time() time.Time { return e.At }
+
+// RateLimited is emitted when Reddit rejects a request with HTTP 429 Too Many Requests
+type RateLimited struct {
+	At  time.Time
+	URL string
+	// Reset is the remaining time until the rate limit window resets, as reported by Reddit
+	Reset time.Duration
+}
+
+// Time returns the moment the event occurred
+func (e RateLimited) Time() time.Time { return e.At }
+
+// PageFetched is emitted after every completed GET request, successful or not
+type PageFetched struct {
+	At         time.Time
+	URL        string
+	UserAgent  string
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+	// Family and Subreddit label the request, Subreddit being empty for requests not targeting one, see SubredditLabels
+	Family    EndpointFamily
+	Subreddit string
+}
+
+// Time returns the moment the event occurred
+func (e PageFetched) Time() time.Time { return e.At }
+
+// EventBus dispatches client events to subscribers. It is safe for concurrent use.
+type EventBus struct {
+	mutex       sync.RWMutex
+	nextID      int
+	subscribers map[int]func(Event)
+}
+
+// NewEventBus creates a new, empty event bus
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[int]func(Event))}
+}
+
+// Subscribe registers a handler invoked synchronously for every published event. The returned function removes the handler.
+func (b *EventBus) Subscribe(handler func(Event)) func() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.subscribers == nil {
+		b.subscribers = make(map[int]func(Event))
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = handler
+
+	return func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// Publish delivers the event to all current subscribers
+func (b *EventBus) Publish(event Event) {
+	b.mutex.RLock()
+	handlers := make([]func(Event), 0, len(b.subscribers))
+	for _, handler := range b.subscribers {
+		handlers = append(handlers, handler)
+	}
+	b.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
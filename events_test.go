@@ -0,0 +1,96 @@
+package redditreadgo
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEventBusDelivery(t *testing.T) {
+
+	bus := NewEventBus()
+	var first, second []Event
+	bus.Subscribe(func(event Event) { first = append(first, event) })
+	unsubscribe := bus.Subscribe(func(event Event) { second = append(second, event) })
+
+	refreshed := TokenRefreshed{At: time.Unix(1, 0), TokenType: "bearer"}
+	bus.Publish(refreshed)
+
+	unsubscribe()
+	unsubscribe()
+	limited := RateLimited{At: time.Unix(2, 0), URL: "https://oauth.reddit.com/r/golang/new"}
+	bus.Publish(limited)
+
+	if len(first) != 2 || first[0] != refreshed || first[1] != limited {
+		t.Errorf("expected both events delivered to the remaining subscriber, got %v", first)
+	}
+	if len(second) != 1 || second[0] != refreshed {
+		t.Errorf("expected only the event published before unsubscribing, got %v", second)
+	}
+}
+
+func TestZeroValueEventBus(t *testing.T) {
+
+	var bus EventBus
+	bus.Publish(TokenRefreshed{})
+
+	delivered := 0
+	bus.Subscribe(func(Event) { delivered++ })
+	bus.Publish(TokenRefreshed{})
+
+	if delivered != 1 {
+		t.Errorf("expected 1 event delivered, got %d", delivered)
+	}
+}
+
+func TestEventBusUnsubscribeFromHandler(t *testing.T) {
+
+	bus := NewEventBus()
+	delivered := 0
+	var unsubscribe func()
+	unsubscribe = bus.Subscribe(func(Event) {
+		delivered++
+		unsubscribe()
+	})
+
+	bus.Publish(TokenRefreshed{})
+	bus.Publish(TokenRefreshed{})
+
+	if delivered != 1 {
+		t.Errorf("expected the handler to stop after unsubscribing itself, called %d times", delivered)
+	}
+}
+
+func TestEventBusConcurrentUnsubscribe(t *testing.T) {
+
+	bus := NewEventBus()
+	var kept int64
+	bus.Subscribe(func(Event) { atomic.AddInt64(&kept, 1) })
+
+	const publishers, events = 4, 100
+	var wg sync.WaitGroup
+	for index := 0; index < publishers; index++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := 0; event < events; event++ {
+				bus.Publish(PageFetched{StatusCode: 200})
+			}
+		}()
+	}
+
+	for index := 0; index < 50; index++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unsubscribe := bus.Subscribe(func(Event) {})
+			unsubscribe()
+		}()
+	}
+	wg.Wait()
+
+	if delivered := atomic.LoadInt64(&kept); delivered != publishers*events {
+		t.Errorf("expected %d events delivered to the remaining subscriber, got %d", publishers*events, delivered)
+	}
+}
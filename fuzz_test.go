@@ -0,0 +1,222 @@
+//go:build go1.18
+// +build go1.18
+
+package redditreadgo
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// urlSafe reports whether the text only holds characters that need no escaping in a URL path segment
+func urlSafe(text string, extra string) bool {
+	for _, r := range text {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' || r == '_' || strings.ContainsRune(extra, r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzValidateSubreddit(f *testing.F) {
+
+	for _, seed := range []string{"golang", "a+b+c", "all", "all-a-b", "popular", "", "r/golang", "gölang", "../admin", "a?b", "a b", "a+all", strings.Repeat("a", 100)} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, subreddit string) {
+		if err := validateSubreddit(subreddit); err != nil {
+			return
+		}
+
+		if !urlSafe(subreddit, "+-") {
+			t.Fatalf("accepted subreddit %q needs escaping", subreddit)
+		}
+
+		parsed, err := url.Parse(QueryURL + "/r/" + subreddit + "/new")
+		if err != nil || parsed.Path != "/r/"+subreddit+"/new" {
+			t.Fatalf("accepted subreddit %q changes the URL path: %v", subreddit, err)
+		}
+
+		if err := validateSubredditName(subreddit); err == nil && strings.ContainsAny(subreddit, "+-") {
+			t.Fatalf("single subreddit name %q holds a combination", subreddit)
+		}
+	})
+}
+
+func FuzzValidateAuthor(f *testing.F) {
+
+	for _, seed := range []string{"spez", "a-b_c", "", "[deleted]", "u/spez", "spëz", "a/../b", "a%2Fb", strings.Repeat("a", 21)} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, author string) {
+		if err := validateAuthor(author); err != nil {
+			return
+		}
+
+		if len(author) == 0 || len(author) > 20 || !urlSafe(author, "-") {
+			t.Fatalf("accepted author %q", author)
+		}
+
+		parsed, err := url.Parse(QueryURL + "/user/" + author + "/submitted")
+		if err != nil || parsed.Path != "/user/"+author+"/submitted" {
+			t.Fatalf("accepted author %q changes the URL path: %v", author, err)
+		}
+	})
+}
+
+func FuzzEncodeValues(f *testing.F) {
+
+	f.Add("GLOBAL", 25, "t3_abc", "", 0, true, "all")
+	f.Add("", -1, "a&b=c", "t3_x", -5, false, "")
+	f.Add("US", 1000, "", "", 1<<40, false, "none")
+
+	f.Fuzz(func(t *testing.T, region string, limit int, after string, before string, count int, detail bool, show string) {
+		options := ListingOptions{Region: Region(region), Limit: limit, After: after, Before: before, Count: count, IncludeSubredditDetail: detail, Show: ShowOption(show)}
+
+		values, err := encodeValues(options)
+		if err != nil {
+			return
+		}
+
+		decoded, err := url.ParseQuery(values.Encode())
+		if err != nil {
+			t.Fatalf("encoded options do not parse back: %v", err)
+		}
+		if decoded.Encode() != values.Encode() {
+			t.Fatalf("expected %q, got %q", values.Encode(), decoded.Encode())
+		}
+		if len(after) > 0 && decoded.Get("after") != after {
+			t.Fatalf("after %q decoded as %q", after, decoded.Get("after"))
+		}
+	})
+}
+
+func FuzzSubmissionIDFromURL(f *testing.F) {
+
+	for _, seed := range []string{
+		"https://www.reddit.com/r/golang/comments/abc123/title/",
+		"old.reddit.com/r/x/comments/t3_xyz/t/c0mm/",
+		"https://redd.it/abc12",
+		"https://www.reddit.com/r/golang/s/AbCdEf",
+		"https://evil.com/r/x/comments/abc",
+		"https://reddit.com.evil.com/comments/abc",
+		"https://redd.it/",
+		"://",
+		"",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, rawURL string) {
+		id, err := submissionIDFromURL(rawURL)
+		if err != nil {
+			return
+		}
+
+		if !submissionIDPattern.MatchString(id) {
+			t.Fatalf("invalid id %q extracted from %q", id, rawURL)
+		}
+
+		parsed, err := parseRedditURL(rawURL)
+		if err != nil {
+			t.Fatalf("id extracted from a URL failing to parse: %v", err)
+		}
+		if host := parsed.Hostname(); host != "redd.it" && host != "reddit.com" && !strings.HasSuffix(host, ".reddit.com") {
+			t.Fatalf("id extracted from host %q", host)
+		}
+	})
+}
+
+func FuzzCombinedSubreddits(f *testing.F) {
+
+	f.Add("golang,rust")
+	f.Add("r/golang, /r/Golang,,rust")
+	f.Add("all,popular")
+
+	f.Fuzz(func(t *testing.T, subreddits string) {
+		combined := CombinedSubreddits(strings.Split(subreddits, ","))
+
+		// any accepted combination must be safe to put into a URL path
+		if err := validateSubreddit(combined); err == nil && !urlSafe(combined, "+-") {
+			t.Fatalf("accepted combination %q needs escaping", combined)
+		}
+	})
+}
+
+func FuzzGatewayURL(f *testing.F) {
+
+	for _, seed := range []string{"proxy.example.com", "proxy.example.com:8443", "", "a/b", "user@host", "host?x", "[::1]:443"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, host string) {
+		client := &ReadOnlyRedditClient{}
+		if err := client.Gateway(GatewayOptions{QueryHost: host, TokenHost: host}); err != nil || len(host) == 0 {
+			return
+		}
+
+		for _, rawURL := range []string{QueryURL + "/r/golang/new?limit=5", TokenURL} {
+			parsed, err := url.Parse(client.gatewayURL(rawURL))
+			if err != nil {
+				t.Fatalf("gateway URL for host %q does not parse: %v", host, err)
+			}
+			if parsed.Host != host {
+				t.Fatalf("expected host %q, got %q", host, parsed.Host)
+			}
+		}
+	})
+}
+
+func FuzzSearchQuery(f *testing.F) {
+
+	f.Add("gopher", "news", "spez")
+	f.Add(`a "quoted" \ term`, "", "")
+	f.Add("", "flair with spaces", "x")
+
+	f.Fuzz(func(t *testing.T, text string, flair string, author string) {
+		query := NewSearchQuery().Text(text).Phrase(text).Flair(flair).Author(author).Not(NewSearchQuery().Text(text)).String()
+
+		values, err := url.ParseQuery(url.Values{"q": {query}}.Encode())
+		if err != nil || values.Get("q") != query {
+			t.Fatalf("query %q does not survive encoding: %v", query, err)
+		}
+	})
+}
+
+func FuzzRequestBuilders(f *testing.F) {
+
+	f.Add("golang", "spez", "abc", 25, 100)
+	f.Add("", "", "", -1, -1)
+	f.Add("gölang", "../x", "t3_", 1<<30, MaxTotal+1)
+
+	f.Fuzz(func(t *testing.T, subreddit string, author string, id string, limit int, total int) {
+		// requests through a client that is not logged in must fail without panicking, whatever the input
+		client := &ReadOnlyRedditClient{}
+		params := ListingOptions{Limit: limit}
+
+		if _, _, err := client.SubmissionsTo(subreddit, NewSubmissions, AllTime, params); err == nil {
+			t.Fatal("expected an error")
+		}
+		if _, err := client.AllSubmissionsTo(subreddit, HotSubmissions, ThisDay, total); err == nil && total != 0 {
+			t.Fatal("expected an error")
+		}
+		if _, _, err := client.SubmissionsOf(author, TopSubmissions, ThisWeek, params); err == nil {
+			t.Fatal("expected an error")
+		}
+		if _, err := client.CommentsOf(id, TopComments, CommentOptions{Limit: limit, Depth: limit}); err == nil {
+			t.Fatal("expected an error")
+		}
+		if _, _, err := client.Search(subreddit, SearchOptions{Subreddit: subreddit, Listing: params}); err == nil {
+			t.Fatal("expected an error")
+		}
+		if _, err := client.SubmissionsByIDs([]string{id}); err == nil {
+			t.Fatal("expected an error")
+		}
+		if _, _, err := client.SubmissionByURL(id, nil); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package redditreadgo
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// GatewayOptions represents alternative hosts standing in for Reddit's, e.g. corporate egress proxies
+type GatewayOptions struct {
+	// QueryHost replaces the host of QueryURL, e.g. reddit-api.proxy.example.com:8443
+	QueryHost string
+	// TokenHost replaces the host of TokenURL
+	TokenHost string
+	// ServerName overrides the name the TLS certificates of the gateway are verified against
+	ServerName string
+}
+
+// Gateway routes the requests of the client through the given alternative hosts. URLs reported by events, audit
+// entries and archives keep Reddit's hosts. Reset by passing empty options. Overriding the server name clones the
+// *http.Transport of the client; custom transports are never replaced, the override failing instead.
+func (c *ReadOnlyRedditClient) Gateway(opts GatewayOptions) error {
+
+	for _, host := range []string{opts.QueryHost, opts.TokenHost} {
+		if len(host) == 0 {
+			continue
+		}
+		parsed, err := url.Parse("https://" + host)
+		if err != nil || parsed.Host != host || len(parsed.Path) > 0 || parsed.User != nil {
+			return fmt.Errorf("invalid gateway host: %q", host)
+		}
+	}
+
+	// the transport is only replaced when a server name is, or was, overridden
+	if c.httpClient == nil || opts.ServerName == c.gateway.ServerName {
+		c.gateway = opts
+		return nil
+	}
+
+	var transport *http.Transport
+	switch current := c.httpClient.Transport.(type) {
+	case nil:
+		transport = newTransport()
+	case *http.Transport:
+		transport = current.Clone()
+	default:
+		return fmt.Errorf("cannot override the server name of a %T transport", current)
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.ServerName = opts.ServerName
+
+	c.gateway = opts
+	c.httpClient = &http.Client{Jar: c.httpClient.Jar, Transport: transport, Timeout: c.httpClient.Timeout}
+	return nil
+}
+
+// newTransport returns a transport configured as http.DefaultTransport
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// gatewayURL returns the URL to send a request for the given Reddit URL to
+func (c *ReadOnlyRedditClient) gatewayURL(rawURL string) string {
+
+	switch {
+	case len(c.gateway.QueryHost) > 0 && strings.HasPrefix(rawURL, QueryURL):
+		return replaceHost(rawURL, QueryURL, c.gateway.QueryHost)
+	case len(c.gateway.TokenHost) > 0 && strings.HasPrefix(rawURL, TokenURL):
+		return replaceHost(rawURL, TokenURL, c.gateway.TokenHost)
+	}
+	return rawURL
+}
+
+func replaceHost(rawURL string, base string, host string) string {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return rawURL
+	}
+	return baseURL.Scheme + "://" + host + strings.TrimPrefix(rawURL, baseURL.Scheme+"://"+baseURL.Host)
+}
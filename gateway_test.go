@@ -0,0 +1,86 @@
+package redditreadgo
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestGatewayServerName(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/user/spez/about": `{"kind":"t2","data":{"name":"spez"}}`}}
+	client := newTestClient(t, fake)
+	opts := client.gateway
+
+	// the certificates of httptest servers are valid for example.com, and only trusted by the transport of the test client
+	opts.ServerName = "example.com"
+	if err := client.Gateway(opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.AboutUser("spez"); err != nil {
+		t.Fatalf("expected the server name to be verified by the cloned transport: %v", err)
+	}
+
+	opts.ServerName = "reddit.invalid"
+	if err := client.Gateway(opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.AboutUser("spez"); err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Fatalf("expected a certificate error, got %v", err)
+	}
+}
+
+func TestGatewayCustomTransport(t *testing.T) {
+
+	client, err := newClient("id", "secret", "redditreadgo-test/1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, os.ErrNotExist })
+	client.httpClient.Transport = transport
+
+	if err := client.Gateway(GatewayOptions{ServerName: "proxy.example.com"}); err == nil {
+		t.Error("expected an error overriding the server name of a custom transport")
+	}
+	if _, ok := client.httpClient.Transport.(roundTripperFunc); !ok {
+		t.Errorf("custom transport replaced by %T", client.httpClient.Transport)
+	}
+	if len(client.gateway.ServerName) > 0 {
+		t.Errorf("gateway changed despite the error: %+v", client.gateway)
+	}
+}
+
+func TestArchiveReplayClientGateway(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "redditreadgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	client, err := NewArchiveReplayClient(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, opts := range []GatewayOptions{{QueryHost: "proxy.example.com"}, {ServerName: "proxy.example.com"}} {
+		if err := client.Gateway(opts); err == nil {
+			t.Errorf("expected an error for %+v", opts)
+		}
+	}
+	if err := client.Gateway(GatewayOptions{}); err != nil {
+		t.Errorf("unexpected error resetting the gateway: %v", err)
+	}
+
+	if _, ok := client.httpClient.Transport.(*replayTransport); !ok || client.gateway != (GatewayOptions{}) {
+		t.Errorf("replay transport or gateway changed: %T, %+v", client.httpClient.Transport, client.gateway)
+	}
+}
@@ -0,0 +1,155 @@
+package redditreadgo
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeReddit answers token requests and serves canned JSON pages by path
+type fakeReddit struct {
+	// expiresIn is the lifetime, in seconds, of the tokens handed out
+	expiresIn int
+	// pages maps request paths to the JSON documents served for them
+	pages map[string]string
+	// contentType overrides the Content-Type of the pages served
+	contentType string
+	// gzip makes the pages served gzip encoded
+	gzip bool
+	// answer, when set, answers the API requests instead of pages and redirects
+	answer http.HandlerFunc
+	// redirects maps request paths to the locations answered with a 301
+	redirects map[string]string
+	// tokens counts the tokens handed out
+	tokens int64
+
+	mutex    sync.Mutex
+	requests []*http.Request
+}
+
+// received returns the API requests the fake received, token requests left out
+func (f *fakeReddit) received() []*http.Request {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]*http.Request(nil), f.requests...)
+}
+
+func (f *fakeReddit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path == "/api/v1/access_token" {
+		n := atomic.AddInt64(&f.tokens, 1)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": fmt.Sprintf("token-%d", n),
+			"token_type":   "bearer",
+			"expires_in":   f.expiresIn,
+			"scope":        "*",
+		})
+		return
+	}
+
+	f.mutex.Lock()
+	f.requests = append(f.requests, r)
+	f.mutex.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "bearer token-") {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if f.answer != nil {
+		f.answer(w, r)
+		return
+	}
+
+	if location, ok := f.redirects[r.URL.Path]; ok {
+		http.Redirect(w, r, location, http.StatusMovedPermanently)
+		return
+	}
+
+	page, ok := f.pages[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	contentType := f.contentType
+	if len(contentType) == 0 {
+		contentType = "application/json; charset=UTF-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+
+	if f.gzip {
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		defer writer.Close()
+		fmt.Fprint(writer, page)
+		return
+	}
+	fmt.Fprint(w, page)
+}
+
+// newTestClient returns a client logged in against the given fake, all its requests routed to it
+func newTestClient(t testing.TB, fake *fakeReddit) *ReadOnlyRedditClient {
+
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := newClient("id", "secret", "redditreadgo-test/1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.httpClient = server.Client()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	if err := client.Gateway(GatewayOptions{QueryHost: host, TokenHost: host}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.loginAuth(); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// commentsPage returns the JSON document Reddit serves for the comments of a submission
+func commentsPage(id string, comments int) string {
+
+	children := make([]string, comments)
+	for index := range children {
+		children[index] = fmt.Sprintf(`{"kind":"t1","data":{"id":"%s_%d","name":"t1_%s_%d","link_id":"t3_%s","body":"comment","replies":""}}`, id, index, id, index, id)
+	}
+
+	return fmt.Sprintf(`[{"kind":"Listing","data":{"children":[{"kind":"t3","data":{"id":"%s","name":"t3_%s","title":"submission"}}]}},`+
+		`{"kind":"Listing","data":{"children":[%s]}}]`, id, id, strings.Join(children, ","))
+}
+
+// auditRecorder keeps the audit entries recorded
+type auditRecorder struct {
+	mutex   sync.Mutex
+	entries []AuditEntry
+}
+
+func (r *auditRecorder) Record(entry AuditEntry) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// infoPage returns the JSON document Reddit serves for the info of the given submission ids
+func infoPage(ids ...string) string {
+
+	children := make([]string, len(ids))
+	for index, id := range ids {
+		children[index] = fmt.Sprintf(`{"kind":"t3","data":{"id":"%s","name":"t3_%s","title":"submission %s"}}`, id, id, id)
+	}
+
+	return fmt.Sprintf(`{"kind":"Listing","data":{"children":[%s]}}`, strings.Join(children, ","))
+}
@@ -0,0 +1,91 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrStopPaging can be returned by page hooks to end a bulk fetch early, e.g. once enough data was collected. The
+// fetch then returns the results collected so far without error.
+var ErrStopPaging = errors.New("stop paging")
+
+// PageHook is called around each page requested by bulk fetches such as AllSubmissionsTo, AllSubmissionsOf and
+// HistoryOf. Returning an error other than ErrStopPaging aborts the fetch, e.g. once a budget is exceeded or the
+// content sought was found; the fetch then returns the results collected so far along with a PageHookError.
+type PageHook func(url string, slice *SliceInfo) error
+
+// PageHookError is returned, along with the results collected so far, by a bulk fetch aborted by a page hook
+type PageHookError struct {
+	// URL is the page the hook was called for
+	URL string
+	// Err is the error returned by the hook
+	Err error
+}
+
+func (e *PageHookError) Error() string {
+	return fmt.Sprintf("page hook aborted the fetch at %s: %v", e.URL, e.Err)
+}
+
+// BeforePage sets the hook called before each page of a bulk fetch is requested, with the page URL and the slice
+// info of the previous page, nil for the first one. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) BeforePage(hook PageHook) {
+	c.beforePage = hook
+}
+
+// AfterPage sets the hook called after each page of a bulk fetch was retrieved, with the page URL and its slice
+// info. Optional, disabled by default.
+func (c *ReadOnlyRedditClient) AfterPage(hook PageHook) {
+	c.afterPage = hook
+}
+
+// pageTracker follows the pages of a single bulk fetch for the page hooks. A nil value tracks nothing.
+type pageTracker struct {
+	before   PageHook
+	after    PageHook
+	url      string
+	previous *SliceInfo
+}
+
+// paged returns a client tracking the pages requested through it, for the duration of a bulk fetch
+func (c *ReadOnlyRedditClient) paged() (*ReadOnlyRedditClient, *pageTracker) {
+
+	if c == nil || (c.beforePage == nil && c.afterPage == nil) {
+		return c, nil
+	}
+
+	scoped := *c
+	scoped.pages = &pageTracker{before: c.beforePage, after: c.afterPage}
+	return &scoped, scoped.pages
+}
+
+func (p *pageTracker) requesting(url string) error {
+	if p == nil {
+		return nil
+	}
+
+	p.url = url
+	if p.before != nil {
+		return hookError(url, p.before(url, p.previous))
+	}
+	return nil
+}
+
+func (p *pageTracker) retrieved(slice *SliceInfo) error {
+	if p == nil {
+		return nil
+	}
+
+	p.previous = slice
+	if p.after != nil {
+		return hookError(p.url, p.after(p.url, slice))
+	}
+	return nil
+}
+
+// hookError wraps the error a page hook returned for the given page, leaving nil and ErrStopPaging as they are
+func hookError(url string, err error) error {
+	if err == nil || err == ErrStopPaging {
+		return err
+	}
+	return &PageHookError{URL: url, Err: err}
+}
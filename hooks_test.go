@@ -0,0 +1,120 @@
+package redditreadgo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var errBudgetExceeded = errors.New("budget exceeded")
+
+func TestPageHooks(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, answer: slicePage}
+	client := newTestClient(t, fake)
+
+	var before, after []*SliceInfo
+	var urls []string
+	client.BeforePage(func(url string, slice *SliceInfo) error {
+		before = append(before, slice)
+		urls = append(urls, url)
+		return nil
+	})
+	client.AfterPage(func(url string, slice *SliceInfo) error {
+		after = append(after, slice)
+		return nil
+	})
+
+	submissions, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, 3*DefaultSliceSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(submissions) != 3*DefaultSliceSize || len(before) != 3 || len(after) != 3 {
+		t.Fatalf("expected 3 pages, got %d submissions, %d and %d hook calls", len(submissions), len(before), len(after))
+	}
+	if before[0] != nil || before[1] != after[0] || before[2] != after[1] {
+		t.Errorf("expected the before hook to get the slice info of the previous page, got %v and %v", before, after)
+	}
+	if !strings.Contains(urls[0], "/r/golang/new") || strings.Contains(urls[0], "after=") || !strings.Contains(urls[1], "after=") {
+		t.Errorf("unexpected page URLs %v", urls)
+	}
+}
+
+func TestPageHooksEndingBulkFetches(t *testing.T) {
+
+	// stopAt returns a hook ending the fetch with the given error at the given page, counting from 1
+	stopAt := func(page int, err error) PageHook {
+		calls := 0
+		return func(string, *SliceInfo) error {
+			if calls++; calls == page {
+				return err
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name string
+		// beforePage and afterPage are the pages the hooks end the fetch at, none if 0
+		beforePage int
+		afterPage  int
+		err        error
+		pages      int
+		expected   int
+	}{
+		{"stop after page", 0, 2, ErrStopPaging, 2, 2 * DefaultSliceSize},
+		{"stop before page", 2, 0, ErrStopPaging, 1, DefaultSliceSize},
+		{"abort after page", 0, 2, errBudgetExceeded, 2, 2 * DefaultSliceSize},
+		{"abort before page", 2, 0, errBudgetExceeded, 1, DefaultSliceSize},
+		{"abort before first page", 1, 0, errBudgetExceeded, 0, 0},
+	}
+
+	fetches := map[string]func(*ReadOnlyRedditClient) (int, error){
+		"AllSubmissionsTo": func(client *ReadOnlyRedditClient) (int, error) {
+			submissions, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, 5*DefaultSliceSize)
+			return len(submissions), err
+		},
+		"AllSubmissionsOf": func(client *ReadOnlyRedditClient) (int, error) {
+			submissions, err := client.AllSubmissionsOf("spez", NewSubmissions, AllTime, 5*DefaultSliceSize)
+			return len(submissions), err
+		},
+		"HistoryOf": func(client *ReadOnlyRedditClient) (int, error) {
+			history, err := client.HistoryOf("spez", time.Unix(0, 0), time.Time{})
+			return len(history), err
+		},
+	}
+
+	for fetch, run := range fetches {
+		for _, test := range tests {
+			t.Run(fetch+"/"+test.name, func(t *testing.T) {
+				fake := &fakeReddit{expiresIn: 3600, answer: slicePage}
+				client := newTestClient(t, fake)
+				client.BeforePage(stopAt(test.beforePage, test.err))
+				client.AfterPage(stopAt(test.afterPage, test.err))
+
+				found, err := run(client)
+				if found != test.expected {
+					t.Errorf("expected the %d items collected so far, got %d", test.expected, found)
+				}
+				if requests := len(fake.received()); requests != test.pages {
+					t.Errorf("expected %d pages requested, got %d", test.pages, requests)
+				}
+
+				if test.err == ErrStopPaging {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					return
+				}
+				hookErr, ok := err.(*PageHookError)
+				if !ok {
+					t.Fatalf("expected a PageHookError, got %v", err)
+				}
+				if hookErr.Err != errBudgetExceeded || !strings.HasPrefix(hookErr.URL, QueryURL+"/") {
+					t.Errorf("expected the hook error for a page URL, got %+v", hookErr)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaxInfoIDs specifies the largest number of fullnames Reddit looks up in a single info request
+const MaxInfoIDs = 100
+
+// SubmissionsByIDs returns the submissions with the given fullnames, or ids, in the given order, making one request
+// per MaxInfoIDs of them. Submissions requested more than once are looked up, and returned, once. Submissions Reddit
+// does not return, e.g. removed ones, are left out.
+func (c *ReadOnlyRedditClient) SubmissionsByIDs(fullnames []string) ([]*Submission, error) {
+
+	names := make([]string, 0, len(fullnames))
+	requested := make(map[string]bool, len(fullnames))
+	for _, fullname := range fullnames {
+		id, err := normalizeSubmissionID(fullname)
+		if err != nil {
+			return nil, err
+		}
+		if name := "t3_" + id; !requested[name] {
+			requested[name] = true
+			names = append(names, name)
+		}
+	}
+
+	byName := make(map[string]*Submission, len(names))
+	for start := 0; start < len(names); start += MaxInfoIDs {
+		end := start + MaxInfoIDs
+		if end > len(names) {
+			end = len(names)
+		}
+
+		queryParams := url.Values{}
+		queryParams.Set("id", strings.Join(names[start:end], ","))
+		queryParams.Set("raw_json", strconv.Itoa(1))
+
+		queryURL := fmt.Sprintf("%s/api/info?%v", QueryURL, queryParams.Encode())
+
+		var response listing
+		if err := c.doGetRequest(queryURL, &response); err != nil {
+			return nil, err
+		}
+
+		submissions, err := decodeSubmissions(response.Data.Children)
+		if err != nil {
+			return nil, err
+		}
+		for _, submission := range submissions {
+			byName[submission.Name] = submission
+		}
+	}
+
+	submissions := make([]*Submission, 0, len(byName))
+	for _, name := range names {
+		if submission, ok := byName[name]; ok {
+			submissions = append(submissions, submission)
+		}
+	}
+
+	return submissions, nil
+}
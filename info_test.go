@@ -0,0 +1,78 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSubmissionsByIDs(t *testing.T) {
+
+	// the fake answers every id it is asked for except the removed one
+	fake := &fakeReddit{expiresIn: 3600, answer: func(w http.ResponseWriter, r *http.Request) {
+		var ids []string
+		for _, name := range strings.Split(r.URL.Query().Get("id"), ",") {
+			if id := strings.TrimPrefix(name, "t3_"); id != "removed" {
+				ids = append(ids, id)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, infoPage(ids...))
+	}}
+	client := newTestClient(t, fake)
+
+	// 150 distinct ids, the first 60 of them asked for twice, in both forms
+	var fullnames, expected []string
+	for index := 0; index < 150; index++ {
+		id := fmt.Sprintf("s%d", index)
+		fullnames = append(fullnames, id)
+		expected = append(expected, id)
+		if index == 10 {
+			fullnames = append(fullnames, "removed")
+		}
+	}
+	for index := 0; index < 60; index++ {
+		fullnames = append(fullnames, fmt.Sprintf("t3_s%d", index))
+	}
+
+	submissions, err := client.SubmissionsByIDs(fullnames)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	requests := fake.received()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests for 151 distinct ids, got %d", len(requests))
+	}
+	first := strings.Split(requests[0].URL.Query().Get("id"), ",")
+	second := strings.Split(requests[1].URL.Query().Get("id"), ",")
+	if len(first) != MaxInfoIDs || len(second) != 51 {
+		t.Errorf("expected batches of %d and 51 ids, got %d and %d", MaxInfoIDs, len(first), len(second))
+	}
+	if first[0] != "t3_s0" || first[11] != "t3_removed" || second[50] != "t3_s149" {
+		t.Errorf("expected the ids batched in the given order, got %v and %v", first, second)
+	}
+
+	if len(submissions) != len(expected) {
+		t.Fatalf("expected %d submissions, duplicates and the removed one left out, got %d", len(expected), len(submissions))
+	}
+	for index, submission := range submissions {
+		if submission.ID != expected[index] {
+			t.Fatalf("expected %s at index %d, got %s", expected[index], index, submission.ID)
+		}
+	}
+}
+
+func TestSubmissionsByIDsInvalidID(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600}
+	client := newTestClient(t, fake)
+
+	if _, err := client.SubmissionsByIDs([]string{"abc", "t1_abc"}); err == nil {
+		t.Error("expected an error for the fullname of a comment")
+	}
+	if requests := len(fake.received()); requests != 0 {
+		t.Errorf("expected no request, got %d", requests)
+	}
+}
@@ -0,0 +1,99 @@
+package redditreadgo
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// OtherSubredditsLabel is the subreddit label of the requests to subreddits past the SubredditLabels limit
+const OtherSubredditsLabel = "other"
+
+// SubredditLabels caps the no. of distinct subreddits labelled on events and audit entries, keeping metrics built
+// on them at a bounded cardinality; requests to further subreddits are labelled OtherSubredditsLabel.
+// A limit of 0, the default, means unlimited.
+func (c *ReadOnlyRedditClient) SubredditLabels(limit int) {
+	c.subredditLabels = newSubredditLabels(limit)
+}
+
+// endpointLabels returns the endpoint family and the subreddit label of the given request URL, the latter empty for
+// requests not targeting a subreddit
+func (c *ReadOnlyRedditClient) endpointLabels(rawURL string) (EndpointFamily, string) {
+
+	if rawURL == TokenURL {
+		return TokenFamily, ""
+	}
+	if rawURL == StatusURL {
+		return StatusFamily, ""
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ""
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	subreddit := ""
+	if len(segments) > 1 && segments[0] == "r" {
+		subreddit = c.subredditLabels.label(strings.ToLower(segments[1]))
+		segments = segments[2:]
+	}
+
+	return endpointFamily(segments), subreddit
+}
+
+// endpointFamily classifies the path segments following the subreddit, if any
+func endpointFamily(segments []string) EndpointFamily {
+
+	contains := func(segment string) bool {
+		for _, candidate := range segments {
+			if candidate == segment {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case contains("search") || contains("subreddit_autocomplete_v2") || contains("recommend"):
+		return SearchFamily
+	case contains("about") || contains("trophies"):
+		return AboutFamily
+	case len(segments) > 0 && segments[0] == "user":
+		return HistoryFamily
+	case contains("comments") || contains("morechildren"):
+		return CommentsFamily
+	}
+	return ListingFamily
+}
+
+// subredditLabels hands out the subreddit labels, up to a limit of distinct ones. A nil value is unlimited.
+type subredditLabels struct {
+	mutex sync.Mutex
+	limit int
+	seen  map[string]bool
+}
+
+func newSubredditLabels(limit int) *subredditLabels {
+	if limit <= 0 {
+		return nil
+	}
+	return &subredditLabels{limit: limit, seen: make(map[string]bool)}
+}
+
+func (l *subredditLabels) label(subreddit string) string {
+	if l == nil {
+		return subreddit
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.seen[subreddit] {
+		if len(l.seen) >= l.limit {
+			return OtherSubredditsLabel
+		}
+		l.seen[subreddit] = true
+	}
+	return subreddit
+}
@@ -0,0 +1,89 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// LiveUpdateKind is the kind Reddit reports for live thread updates
+const LiveUpdateKind = "LiveUpdate"
+
+var liveThreadIDPattern = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+// LiveThread returns the metadata of the given live thread
+func (c *ReadOnlyRedditClient) LiveThread(threadID string) (*LiveThread, error) {
+
+	if err := validateLiveThreadID(threadID); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/live/%s/about?raw_json=1", QueryURL, threadID)
+
+	type Response struct {
+		Kind string
+		Data *LiveThread
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return nil, fmt.Errorf("no live thread returned for %s", threadID)
+	}
+
+	return response.Data, nil
+}
+
+// LiveUpdatesOf returns the updates of the given live thread, newest first, considering listing options
+func (c *ReadOnlyRedditClient) LiveUpdatesOf(threadID string, params ListingOptions) ([]*LiveUpdate, *SliceInfo, error) {
+
+	if err := validateLiveThreadID(threadID); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/live/%s?%v", QueryURL, threadID, queryParams.Encode())
+
+	response := new(listing)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, nil, err
+	}
+
+	updates := make([]*LiveUpdate, 0, len(response.Data.Children))
+	for _, child := range response.Data.Children {
+		if child.Kind != LiveUpdateKind {
+			continue
+		}
+		update := new(LiveUpdate)
+		if err := json.Unmarshal(child.Data, update); err != nil {
+			return nil, nil, err
+		}
+		updates = append(updates, update)
+	}
+
+	return updates, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+func validateLiveThreadID(threadID string) error {
+
+	if len(threadID) == 0 {
+		return errors.New("live thread id cannot be null nor empty")
+	}
+
+	if !liveThreadIDPattern.MatchString(threadID) {
+		return fmt.Errorf("invalid live thread id: %q", threadID)
+	}
+
+	return nil
+}
@@ -1,70 +1,168 @@
 package redditreadgo
 
+import (
+	"errors"
+	"fmt"
+	"html"
+)
+
 // Submission represents an individual post from the perspective of a subreddit
 type Submission struct {
-	ApprovedAtUTC         float64 `json:"approved_at_utc"`
-	ApprovedBy            string  `json:"approved_by"`
-	Archived              bool    `json:"archived"`
-	Author                string  `json:"author"`
-	BannedAtUTC           float64 `json:"banned_at_utc"`
-	BannedBy              string  `json:"banned_by"`
-	CanGlid               bool    `json:"can_gild"`
-	Category              string  `json:"category"`
-	Clicked               bool    `json:"clicked"`
-	ContentCategories     string  `json:"content_categories"`
-	ContestMode           bool    `json:"contest_mode"`
-	Created               float64 `json:"created"`
-	CreatedUTC            float64 `json:"created_utc"`
-	Distinguished         string  `json:"distinguished"`
-	Domain                string  `json:"domain"`
-	Downs                 int     `json:"downs"`
-	Edited                bool    `json:"edited"`
-	Glided                uint64  `json:"gilded"`
-	Hidden                bool    `json:"hidden"`
-	HideScore             bool    `json:"hide_score"`
-	ID                    string  `json:"id"`
-	IsCrosspostable       bool    `json:"is_crosspostable"`
-	IsOriginalContent     bool    `json:"is_original_content"`
-	IsRedditMediaDomain   bool    `json:"is_reddit_media_domain"`
-	IsSelf                bool    `json:"is_self"`
-	IsVideo               bool    `json:"is_video"`
-	Likes                 string  `json:"likes"`
-	Locked                bool    `json:"locked"`
-	MediaOnly             bool    `json:"media_only"`
-	Name                  string  `json:"name"`
-	NoFollow              bool    `json:"no_follow"`
-	NumComments           uint64  `json:"num_comments"`
-	NumCrossposts         uint64  `json:"num_crossposts"`
-	NumReports            uint64  `json:"num_reports"`
-	Over18                bool    `json:"over_18"`
-	ParentWhitelistStatus string  `json:"parent_whitelist_status"`
-	Permalink             string  `json:"permalink"`
-	Pinned                bool    `json:"pinned"`
-	PostCategories        string  `json:"post_categories"`
-	PostHint              string  `json:"post_hint"`
-	Quarantine            bool    `json:"quarantine"`
-	RemovalReason         string  `json:"removal_reason"`
-	ReportReasons         string  `json:"report_reasons"`
-	Saved                 bool    `json:"saved"`
-	Score                 uint64  `json:"score"`
-	Selftext              string  `json:"selftext"`
-	SelftextHTML          string  `json:"selftext_html"`
-	SendReplies           bool    `json:"send_replies"`
-	Spoiler               bool    `json:"spoiler"`
-	Stickied              bool    `json:"stickied"`
-	Subreddit             string  `json:"subreddit"`
-	SubredditID           string  `json:"subreddit_id"`
-	SubredditNamePrefixed string  `json:"subreddit_name_prefixed"`
-	SubredditSubscribers  uint64  `json:"subreddit_subscribers"`
-	SubredditType         string  `json:"subreddit_type"`
-	SuggestedSort         string  `json:"suggested_sort"`
-	Thumbnail             string  `json:"thumbnail"`
-	Title                 string  `json:"title"`
-	Ups                   int     `json:"ups"`
-	URL                   string  `json:"url"`
-	ViewCount             uint64  `json:"view_count"`
-	Visited               bool    `json:"visited"`
-	WhitelistStatus       string  `json:"whitelist_status"`
+	ApprovedAtUTC         float64  `json:"approved_at_utc"`
+	ApprovedBy            string   `json:"approved_by"`
+	Archived              bool     `json:"archived"`
+	Author                string   `json:"author"`
+	AuthorFullname        string   `json:"author_fullname"`
+	BannedAtUTC           float64  `json:"banned_at_utc"`
+	BannedBy              string   `json:"banned_by"`
+	CanGlid               bool     `json:"can_gild"`
+	Category              string   `json:"category"`
+	Clicked               bool     `json:"clicked"`
+	ContentCategories     string   `json:"content_categories"`
+	ContestMode           bool     `json:"contest_mode"`
+	Created               float64  `json:"created"`
+	CreatedUTC            float64  `json:"created_utc"`
+	Distinguished         string   `json:"distinguished"`
+	Domain                string   `json:"domain"`
+	Downs                 int      `json:"downs"`
+	Edited                bool     `json:"edited"`
+	EditedUTC             float64  `json:"-"`
+	Glided                uint64   `json:"gilded"`
+	Hidden                bool     `json:"hidden"`
+	HideScore             bool     `json:"hide_score"`
+	ID                    string   `json:"id"`
+	IsCrosspostable       bool     `json:"is_crosspostable"`
+	IsOriginalContent     bool     `json:"is_original_content"`
+	IsRedditMediaDomain   bool     `json:"is_reddit_media_domain"`
+	IsSelf                bool     `json:"is_self"`
+	IsVideo               bool     `json:"is_video"`
+	Likes                 string   `json:"likes"`
+	Locked                bool     `json:"locked"`
+	MediaOnly             bool     `json:"media_only"`
+	Name                  string   `json:"name"`
+	NoFollow              bool     `json:"no_follow"`
+	NumComments           uint64   `json:"num_comments"`
+	NumCrossposts         uint64   `json:"num_crossposts"`
+	NumReports            uint64   `json:"num_reports"`
+	Over18                bool     `json:"over_18"`
+	ParentWhitelistStatus string   `json:"parent_whitelist_status"`
+	Permalink             string   `json:"permalink"`
+	Pinned                bool     `json:"pinned"`
+	PostCategories        string   `json:"post_categories"`
+	PostHint              string   `json:"post_hint"`
+	Preview               *Preview `json:"preview"`
+	Quarantine            bool     `json:"quarantine"`
+	RemovalReason         string   `json:"removal_reason"`
+	ReportReasons         string   `json:"report_reasons"`
+	Saved                 bool     `json:"saved"`
+	Score                 uint64   `json:"score"`
+	Selftext              string   `json:"selftext"`
+	SelftextHTML          string   `json:"selftext_html"`
+	SendReplies           bool     `json:"send_replies"`
+	Spoiler               bool     `json:"spoiler"`
+	Stickied              bool     `json:"stickied"`
+	Subreddit             string   `json:"subreddit"`
+	// SubredditDetail is the subreddit the submission was made to, only set when requested via IncludeSubredditDetail
+	SubredditDetail       *Subreddit `json:"sr_detail"`
+	SubredditID           string     `json:"subreddit_id"`
+	SubredditNamePrefixed string     `json:"subreddit_name_prefixed"`
+	SubredditSubscribers  uint64     `json:"subreddit_subscribers"`
+	SubredditType         string     `json:"subreddit_type"`
+	SuggestedSort         string     `json:"suggested_sort"`
+	Thumbnail             string     `json:"thumbnail"`
+	Title                 string     `json:"title"`
+	Ups                   int        `json:"ups"`
+	UpvoteRatio           float64    `json:"upvote_ratio"`
+	URL                   string     `json:"url"`
+	ViewCount             uint64     `json:"view_count"`
+	Visited               bool       `json:"visited"`
+	WhitelistStatus       string     `json:"whitelist_status"`
+	authorInfo            *AuthorInfo
+}
+
+// Comment represents an individual comment, or a stub of collapsed comments when More is set
+type Comment struct {
+	ApprovedAtUTC         float64       `json:"approved_at_utc"`
+	ApprovedBy            string        `json:"approved_by"`
+	Archived              bool          `json:"archived"`
+	Author                string        `json:"author"`
+	AuthorFullname        string        `json:"author_fullname"`
+	BannedAtUTC           float64       `json:"banned_at_utc"`
+	BannedBy              string        `json:"banned_by"`
+	Body                  string        `json:"body"`
+	BodyHTML              string        `json:"body_html"`
+	CanGild               bool          `json:"can_gild"`
+	Collapsed             bool          `json:"collapsed"`
+	CollapsedReason       string        `json:"collapsed_reason"`
+	Controversiality      int           `json:"controversiality"`
+	Created               float64       `json:"created"`
+	CreatedUTC            float64       `json:"created_utc"`
+	Depth                 int           `json:"depth"`
+	Distinguished         string        `json:"distinguished"`
+	Downs                 int           `json:"downs"`
+	Edited                bool          `json:"edited"`
+	EditedUTC             float64       `json:"-"`
+	Gilded                uint64        `json:"gilded"`
+	ID                    string        `json:"id"`
+	IsSubmitter           bool          `json:"is_submitter"`
+	Likes                 string        `json:"likes"`
+	LinkID                string        `json:"link_id"`
+	Locked                bool          `json:"locked"`
+	Name                  string        `json:"name"`
+	ParentID              string        `json:"parent_id"`
+	Permalink             string        `json:"permalink"`
+	Score                 int           `json:"score"`
+	ScoreHidden           bool          `json:"score_hidden"`
+	SendReplies           bool          `json:"send_replies"`
+	Stickied              bool          `json:"stickied"`
+	Subreddit             string        `json:"subreddit"`
+	SubredditID           string        `json:"subreddit_id"`
+	SubredditNamePrefixed string        `json:"subreddit_name_prefixed"`
+	SubredditType         string        `json:"subreddit_type"`
+	Ups                   int           `json:"ups"`
+	Replies               []*Comment    `json:"-"`
+	More                  *MoreComments `json:"-"`
+}
+
+// MoreComments represents comments Reddit left out of a comment tree, which can be retrieved separately
+type MoreComments struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	ParentID string   `json:"parent_id"`
+	Count    int      `json:"count"`
+	Depth    int      `json:"depth"`
+	Children []string `json:"children"`
+}
+
+// Preview represents the preview images Reddit generates for a submission
+type Preview struct {
+	Images  []*PreviewImage `json:"images"`
+	Enabled bool            `json:"enabled"`
+}
+
+// PreviewImage represents a preview image in its original size and downscaled resolutions, with its variants
+type PreviewImage struct {
+	ID          string           `json:"id"`
+	Source      *PreviewSource   `json:"source"`
+	Resolutions []*PreviewSource `json:"resolutions"`
+	Variants    PreviewVariants  `json:"variants"`
+}
+
+// PreviewVariants represents the alternative renditions of a preview image
+type PreviewVariants struct {
+	// Obfuscated is the blurred rendition, present for NSFW and spoiler submissions
+	Obfuscated *PreviewImage `json:"obfuscated"`
+	// NSFW is the blurred rendition, present for NSFW submissions
+	NSFW *PreviewImage `json:"nsfw"`
+	GIF  *PreviewImage `json:"gif"`
+	MP4  *PreviewImage `json:"mp4"`
+}
+
+// PreviewSource represents a single rendition of a preview image
+type PreviewSource struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
 }
 
 // TokenAsJSON represents the access token serialized as a json object
@@ -77,6 +175,8 @@ type TokenAsJSON struct {
 	RefreshToken string `json:"refresh_token"`
 	// ExpiresIn value
 	ExpiresIn int32 `json:"expires_in"`
+	// Scope value, space separated
+	Scope string `json:"scope"`
 }
 
 // SliceInfo represents after and before pointers for retrieving the next slice of submissions
@@ -85,6 +185,193 @@ type SliceInfo struct {
 	Before string
 }
 
+// Subreddit represents a subreddit, as described by its about page
+type Subreddit struct {
+	ID                    string  `json:"id"`
+	Name                  string  `json:"name"`
+	DisplayName           string  `json:"display_name"`
+	DisplayNamePrefixed   string  `json:"display_name_prefixed"`
+	Title                 string  `json:"title"`
+	PublicDescription     string  `json:"public_description"`
+	Description           string  `json:"description"`
+	SubmitText            string  `json:"submit_text"`
+	SubmitTextLabel       string  `json:"submit_text_label"`
+	SubmitLinkLabel       string  `json:"submit_link_label"`
+	HeaderImg             string  `json:"header_img"`
+	HeaderTitle           string  `json:"header_title"`
+	IconImg               string  `json:"icon_img"`
+	CommunityIcon         string  `json:"community_icon"`
+	BannerImg             string  `json:"banner_img"`
+	BannerBackgroundImage string  `json:"banner_background_image"`
+	BannerBackgroundColor string  `json:"banner_background_color"`
+	MobileBannerImage     string  `json:"mobile_banner_image"`
+	PrimaryColor          string  `json:"primary_color"`
+	KeyColor              string  `json:"key_color"`
+	Subscribers           uint64  `json:"subscribers"`
+	ActiveUserCount       uint64  `json:"active_user_count"`
+	Over18                bool    `json:"over18"`
+	Quarantine            bool    `json:"quarantine"`
+	SubredditType         string  `json:"subreddit_type"`
+	SubmissionType        string  `json:"submission_type"`
+	Lang                  string  `json:"lang"`
+	WikiEnabled           bool    `json:"wiki_enabled"`
+	URL                   string  `json:"url"`
+	Created               float64 `json:"created"`
+	CreatedUTC            float64 `json:"created_utc"`
+}
+
+// Icon returns the URL of the icon of the subreddit, preferring the community icon over the legacy one, empty if it
+// has none. HTML entities Reddit escapes image URLs with, as in &amp;, are unescaped.
+func (s *Subreddit) Icon() string {
+	if len(s.CommunityIcon) > 0 {
+		return html.UnescapeString(s.CommunityIcon)
+	}
+	return html.UnescapeString(s.IconImg)
+}
+
+// Banner returns the URL of the banner of the subreddit, preferring the banner background image over the legacy
+// banner, empty if it has none. HTML entities are unescaped as for Icon.
+func (s *Subreddit) Banner() string {
+	if len(s.BannerBackgroundImage) > 0 {
+		return html.UnescapeString(s.BannerBackgroundImage)
+	}
+	return html.UnescapeString(s.BannerImg)
+}
+
+// Color returns the theme color of the subreddit as a hex code, preferring the primary color over the key color,
+// empty if it has none
+func (s *Subreddit) Color() string {
+	if len(s.PrimaryColor) > 0 {
+		return s.PrimaryColor
+	}
+	return s.KeyColor
+}
+
+// SubredditRule represents a rule of a subreddit
+type SubredditRule struct {
+	// Kind is the kind of content the rule applies to: link, comment or all
+	Kind            string  `json:"kind"`
+	ShortName       string  `json:"short_name"`
+	Description     string  `json:"description"`
+	DescriptionHTML string  `json:"description_html"`
+	ViolationReason string  `json:"violation_reason"`
+	Priority        int     `json:"priority"`
+	CreatedUTC      float64 `json:"created_utc"`
+}
+
+// Moderator represents a moderator of a subreddit
+type Moderator struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Date is the moment the account became moderator, in seconds since the epoch, UTC
+	Date float64 `json:"date"`
+	// ModPermissions are the permissions granted, "all" standing for every one of them
+	ModPermissions  []string `json:"mod_permissions"`
+	AuthorFlairText string   `json:"author_flair_text"`
+}
+
+// Account represents a Reddit user account, as described by its about page
+type Account struct {
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	LinkKarma        int     `json:"link_karma"`
+	CommentKarma     int     `json:"comment_karma"`
+	AwardeeKarma     int     `json:"awardee_karma"`
+	AwarderKarma     int     `json:"awarder_karma"`
+	TotalKarma       int     `json:"total_karma"`
+	Verified         bool    `json:"verified"`
+	HasVerifiedEmail bool    `json:"has_verified_email"`
+	IsGold           bool    `json:"is_gold"`
+	IsMod            bool    `json:"is_mod"`
+	IsEmployee       bool    `json:"is_employee"`
+	IsSuspended      bool    `json:"is_suspended"`
+	IconImg          string  `json:"icon_img"`
+	SnoovatarImg     string  `json:"snoovatar_img"`
+	Created          float64 `json:"created"`
+	CreatedUTC       float64 `json:"created_utc"`
+}
+
+// Trophy represents a trophy awarded to a user
+type Trophy struct {
+	ID          string `json:"id"`
+	AwardID     string `json:"award_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Icon70      string `json:"icon_70"`
+	Icon40      string `json:"icon_40"`
+	// GrantedAt is the moment the trophy was awarded, in seconds since the epoch, 0 if unknown
+	GrantedAt float64 `json:"granted_at"`
+}
+
+// SubredditSuggestion represents a lightweight subreddit entry, as returned by autocompletion
+type SubredditSuggestion struct {
+	ID                  string  `json:"id"`
+	Name                string  `json:"name"`
+	DisplayName         string  `json:"display_name"`
+	DisplayNamePrefixed string  `json:"display_name_prefixed"`
+	Title               string  `json:"title"`
+	Subscribers         uint64  `json:"subscribers"`
+	ActiveUserCount     uint64  `json:"active_user_count"`
+	IconImg             string  `json:"icon_img"`
+	CommunityIcon       string  `json:"community_icon"`
+	Over18              bool    `json:"over18"`
+	URL                 string  `json:"url"`
+	Created             float64 `json:"created"`
+	CreatedUTC          float64 `json:"created_utc"`
+}
+
+// Stylesheet represents the custom CSS of a subreddit along with the images it references
+type Stylesheet struct {
+	SubredditID string             `json:"subreddit_id"`
+	Stylesheet  string             `json:"stylesheet"`
+	Images      []*StylesheetImage `json:"images"`
+}
+
+// StylesheetImage represents an image uploaded to a subreddit for use in its stylesheet
+type StylesheetImage struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Link is the CSS reference to the image, e.g. url(%%name%%)
+	Link string `json:"link"`
+}
+
+// SubmissionCountEstimate represents the estimated no. of submissions made to a subreddit within a period of time
+type SubmissionCountEstimate struct {
+	// Count is the estimated no. of submissions
+	Count int
+	// Exact is true when the whole period was covered by the sampled listing, hence Count is not extrapolated
+	Exact bool
+	// Sampled is the no. of submissions retrieved for the estimation
+	Sampled int
+	// Requests is the no. of listing requests spent on the estimation
+	Requests int
+}
+
+// MaxCommentContext and MaxCommentTruncate specify the largest context, resp. truncate, Reddit accepts
+const (
+	MaxCommentContext  = 8
+	MaxCommentTruncate = 50
+)
+
+// CommentOptions represents comment tree query url parameters. More info: https://www.reddit.com/dev/api/
+type CommentOptions struct {
+	// Depth - the maximum depth of subtrees in the thread
+	Depth int `url:"depth,omitempty"`
+
+	// Limit - the maximum number of comments to return
+	Limit int `url:"limit,omitempty"`
+
+	// Comment - optional parameter; the id of the comment to focus on, the returned tree being rooted at it
+	Comment string `url:"comment,omitempty"`
+
+	// Context - the number of parents shown above the focused comment, between 0 and 8; requires Comment
+	Context int `url:"context,omitempty"`
+
+	// Truncate - the number of comments to return before truncating the tree, between 0 and 50, 0 meaning no truncation
+	Truncate int `url:"truncate,omitempty"`
+}
+
 // ListingOptions represents listings query url parameters. More info: https://www.reddit.com/dev/api/
 type ListingOptions struct {
 	// Region - filter hot results by specifying the region
@@ -102,6 +389,178 @@ type ListingOptions struct {
 	// Count - the number of items already seen in this listing - default: 0
 	Count int `url:"count,omitempty"`
 
-	// Show - optional parameter; if all is passed, filters such as "hide links that I have voted on" will be disabled
-	Show string `url:"show,omitempty"`
+	// IncludeSubredditDetail - optional parameter; if true, subreddit details are embedded into each submission of the
+	// response, see Submission.SubredditDetail. Each submission carries the subreddit it was made to, which varies
+	// between the submissions of combined subreddits, all, popular and author listings
+	IncludeSubredditDetail bool `url:"sr_detail,omitempty,int"`
+
+	// Show - optional parameter; if ShowAll is passed, filters such as "hide links that I have voted on" will be disabled
+	Show ShowOption `url:"show,omitempty"`
+}
+
+func (o ListingOptions) validate() error {
+
+	if len(o.After) > 0 && len(o.Before) > 0 {
+		return errors.New("after and before cannot be both specified")
+	}
+
+	if o.Limit < 0 {
+		return errors.New("limit cannot be negative")
+	}
+
+	if o.Count < 0 {
+		return errors.New("count cannot be negative")
+	}
+
+	if err := o.Show.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o CommentOptions) validate() error {
+
+	if o.Depth < 0 {
+		return errors.New("depth cannot be negative")
+	}
+
+	if o.Limit < 0 {
+		return errors.New("limit cannot be negative")
+	}
+
+	if len(o.Comment) > 0 && !submissionIDPattern.MatchString(o.Comment) {
+		return fmt.Errorf("invalid comment id: %q", o.Comment)
+	}
+
+	if o.Context < 0 || o.Context > MaxCommentContext {
+		return fmt.Errorf("context must be between 0 and %d", MaxCommentContext)
+	}
+
+	if o.Context > 0 && len(o.Comment) == 0 {
+		return errors.New("context requires a comment to focus on")
+	}
+
+	if o.Truncate < 0 || o.Truncate > MaxCommentTruncate {
+		return fmt.Errorf("truncate must be between 0 and %d", MaxCommentTruncate)
+	}
+
+	return nil
+}
+
+// SearchOptions represents search query url parameters. More info: https://www.reddit.com/dev/api/#GET_search
+type SearchOptions struct {
+	// Subreddit - optional parameter; restricts the search to the given subreddit, or a+b combination of subreddits
+	Subreddit string `url:"-"`
+
+	// Sort - the order of the results - default: relevance
+	Sort SearchSort `url:"sort,omitempty"`
+
+	// Age - restricts the results to the given period - default: all
+	Age AgeSort `url:"t,omitempty"`
+
+	// Listing - the pagination of the results
+	Listing ListingOptions `url:"-"`
+}
+
+func (o SearchOptions) validate() error {
+
+	if len(o.Subreddit) > 0 {
+		if err := validateSubreddit(o.Subreddit); err != nil {
+			return err
+		}
+	}
+
+	if err := o.Sort.validate(); err != nil {
+		return err
+	}
+
+	return o.Age.validate()
+}
+
+// LiveThread represents the metadata of a live thread
+type LiveThread struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	Title             string  `json:"title"`
+	Description       string  `json:"description"`
+	DescriptionHTML   string  `json:"description_html"`
+	Resources         string  `json:"resources"`
+	ResourcesHTML     string  `json:"resources_html"`
+	State             string  `json:"state"`
+	NSFW              bool    `json:"nsfw"`
+	ViewerCount       int     `json:"viewer_count"`
+	ViewerCountFuzzed bool    `json:"viewer_count_fuzzed"`
+	WebsocketURL      string  `json:"websocket_url"`
+	Created           float64 `json:"created"`
+	CreatedUTC        float64 `json:"created_utc"`
+}
+
+// LiveUpdate represents an entry of a live thread
+type LiveUpdate struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Author   string `json:"author"`
+	Body     string `json:"body"`
+	BodyHTML string `json:"body_html"`
+	// Stricken reports whether the update was struck through, i.e. retracted
+	Stricken   bool    `json:"stricken"`
+	Created    float64 `json:"created"`
+	CreatedUTC float64 `json:"created_utc"`
+}
+
+// Multireddit represents a multireddit, i.e. a named collection of subreddits curated by a user
+type Multireddit struct {
+	Name            string `json:"name"`
+	DisplayName     string `json:"display_name"`
+	Owner           string `json:"owner"`
+	OwnerID         string `json:"owner_id"`
+	Path            string `json:"path"`
+	Description     string `json:"description_md"`
+	DescriptionHTML string `json:"description_html"`
+	// Visibility is one of public, private or hidden; only public multireddits can be read by other users
+	Visibility     string                 `json:"visibility"`
+	IconURL        string                 `json:"icon_url"`
+	KeyColor       string                 `json:"key_color"`
+	Subreddits     []MultiredditSubreddit `json:"subreddits"`
+	NumSubscribers int                    `json:"num_subscribers"`
+	Over18         bool                   `json:"over_18"`
+	Created        float64                `json:"created"`
+	CreatedUTC     float64                `json:"created_utc"`
+}
+
+// MultiredditSubreddit represents a subreddit of a multireddit
+type MultiredditSubreddit struct {
+	Name string `json:"name"`
+}
+
+// FlairTemplate represents a flair offered by a subreddit
+type FlairTemplate struct {
+	ID       string `json:"id"`
+	Text     string `json:"text"`
+	CSSClass string `json:"css_class"`
+	// Type is either text or richtext
+	Type string `json:"type"`
+	// TextColor is either dark or light
+	TextColor       string `json:"text_color"`
+	BackgroundColor string `json:"background_color"`
+	// ModOnly reports whether only moderators can assign the flair
+	ModOnly      bool `json:"mod_only"`
+	TextEditable bool `json:"text_editable"`
+	// AllowableContent is one of all, emoji or text
+	AllowableContent string `json:"allowable_content"`
+	MaxEmojis        int    `json:"max_emojis"`
+}
+
+// Emoji represents an emoji usable in flairs, and in comments of the subreddits allowing it
+type Emoji struct {
+	// Name is the name the emoji is referenced by, as in :name:
+	Name string `json:"-"`
+	URL  string `json:"url"`
+	// Snoomoji reports whether the emoji is one of the snoomojis Reddit provides to every subreddit
+	Snoomoji         bool   `json:"-"`
+	UserFlairAllowed bool   `json:"user_flair_allowed"`
+	PostFlairAllowed bool   `json:"post_flair_allowed"`
+	ModFlairOnly     bool   `json:"mod_flair_only"`
+	CreatedBy        string `json:"created_by"`
 }
@@ -0,0 +1,41 @@
+package redditreadgo
+
+import "testing"
+
+func TestSubredditImages(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		subreddit Subreddit
+		icon      string
+		banner    string
+		color     string
+	}{
+		{"none", Subreddit{}, "", "", ""},
+		{"legacy", Subreddit{IconImg: "https://b.thumbs.redditmedia.com/icon.png", BannerImg: "https://b.thumbs.redditmedia.com/banner.png", KeyColor: "#24a0ed"},
+			"https://b.thumbs.redditmedia.com/icon.png", "https://b.thumbs.redditmedia.com/banner.png", "#24a0ed"},
+		{"escaped", Subreddit{
+			IconImg:               "https://b.thumbs.redditmedia.com/icon.png",
+			CommunityIcon:         "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_a.png?width=256&amp;s=1f2e",
+			BannerImg:             "https://b.thumbs.redditmedia.com/banner.png",
+			BannerBackgroundImage: "https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_b.png?width=4000&amp;s=3a4b",
+			PrimaryColor:          "#0079d3",
+			KeyColor:              "#24a0ed",
+		}, "https://styles.redditmedia.com/t5_2rc7j/styles/communityIcon_a.png?width=256&s=1f2e",
+			"https://styles.redditmedia.com/t5_2rc7j/styles/bannerBackgroundImage_b.png?width=4000&s=3a4b", "#0079d3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if icon := test.subreddit.Icon(); icon != test.icon {
+				t.Errorf("expected icon %q, got %q", test.icon, icon)
+			}
+			if banner := test.subreddit.Banner(); banner != test.banner {
+				t.Errorf("expected banner %q, got %q", test.banner, banner)
+			}
+			if color := test.subreddit.Color(); color != test.color {
+				t.Errorf("expected color %q, got %q", test.color, color)
+			}
+		})
+	}
+}
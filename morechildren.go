@@ -0,0 +1,186 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaxMoreChildren specifies the largest number of comment ids Reddit expands in a single morechildren request
+const MaxMoreChildren = 100
+
+// MoreChildren retrieves the comments collapsed behind the given stub of the given submission, as a tree rooted at
+// the stub's parent. Stubs listing more than MaxMoreChildren ids take several requests.
+func (c *ReadOnlyRedditClient) MoreChildren(submissionID string, sort CommentSort, more *MoreComments) ([]*Comment, error) {
+
+	id, err := normalizeSubmissionID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, err
+	}
+
+	if more == nil {
+		return nil, errors.New("more cannot be null")
+	}
+
+	var flat []*Comment
+	for start := 0; start < len(more.Children); start += MaxMoreChildren {
+		end := start + MaxMoreChildren
+		if end > len(more.Children) {
+			end = len(more.Children)
+		}
+
+		comments, err := c.moreChildren(id, sort, more.Children[start:end])
+		if err != nil {
+			return nil, err
+		}
+		flat = append(flat, comments...)
+	}
+
+	return buildCommentTree(flat), nil
+}
+
+// ExpandAll replaces the collapsed comment stubs of the given tree with the comments they stand for, recursively,
+// and returns the expanded tree. At most maxRequests requests are made, all subject to the throttle; stubs left
+// unexpanded once the budget is spent remain in place, holding the ids not retrieved yet. Stubs without ids, which
+// Reddit uses to link to deeper threads, are left as they are.
+func (c *ReadOnlyRedditClient) ExpandAll(submissionID string, sort CommentSort, comments []*Comment, maxRequests int) ([]*Comment, error) {
+
+	id, err := normalizeSubmissionID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, err
+	}
+
+	if maxRequests < 0 {
+		return nil, errors.New("maxRequests cannot be negative")
+	}
+
+	expander := &commentExpander{client: c, submissionID: id, sort: sort, remaining: maxRequests}
+	return expander.expand(comments)
+}
+
+type commentExpander struct {
+	client       *ReadOnlyRedditClient
+	submissionID string
+	sort         CommentSort
+	remaining    int
+}
+
+func (e *commentExpander) expand(comments []*Comment) ([]*Comment, error) {
+
+	expanded := make([]*Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment == nil {
+			continue
+		}
+
+		if !comment.IsMore() {
+			replies, err := e.expand(comment.Replies)
+			if err != nil {
+				return nil, err
+			}
+			comment.Replies = replies
+			expanded = append(expanded, comment)
+			continue
+		}
+
+		children := comment.More.Children
+		var flat []*Comment
+		for len(children) > 0 && e.remaining > 0 {
+			batch := children
+			if len(batch) > MaxMoreChildren {
+				batch = batch[:MaxMoreChildren]
+			}
+
+			e.remaining--
+			retrieved, err := e.client.moreChildren(e.submissionID, e.sort, batch)
+			if err != nil {
+				return nil, err
+			}
+			flat = append(flat, retrieved...)
+			children = children[len(batch):]
+		}
+
+		retrieved, err := e.expand(buildCommentTree(flat))
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, retrieved...)
+
+		if len(children) > 0 || len(comment.More.Children) == 0 {
+			more := *comment.More
+			more.Children = children
+			comment.More = &more
+			expanded = append(expanded, comment)
+		}
+	}
+
+	return expanded, nil
+}
+
+// moreChildren makes a single morechildren request for the given comment ids, returning the comments in the order
+// Reddit lists them, without nesting
+func (c *ReadOnlyRedditClient) moreChildren(submissionID string, sort CommentSort, children []string) ([]*Comment, error) {
+
+	queryParams := url.Values{}
+	queryParams.Set("api_type", "json")
+	queryParams.Set("link_id", "t3_"+submissionID)
+	queryParams.Set("children", strings.Join(children, ","))
+	if len(sort) > 0 {
+		queryParams.Set("sort", string(sort))
+	}
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/api/morechildren?%v", QueryURL, queryParams.Encode())
+
+	type Response struct {
+		JSON struct {
+			Errors [][]string `json:"errors"`
+			Data   struct {
+				Things []thing `json:"things"`
+			} `json:"data"`
+		} `json:"json"`
+	}
+
+	var response Response
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, err
+	}
+
+	if len(response.JSON.Errors) > 0 {
+		return nil, fmt.Errorf("cannot expand comments: %s", strings.Join(response.JSON.Errors[0], ": "))
+	}
+
+	return decodeComments(response.JSON.Data.Things)
+}
+
+// buildCommentTree nests comments listed flat under their parents; comments whose parent is not listed are roots
+func buildCommentTree(flat []*Comment) []*Comment {
+
+	byName := make(map[string]*Comment, len(flat))
+	for _, comment := range flat {
+		if !comment.IsMore() {
+			byName[comment.Name] = comment
+		}
+	}
+
+	roots := make([]*Comment, 0, len(flat))
+	for _, comment := range flat {
+		if parent, ok := byName[comment.ParentID]; ok && parent != comment {
+			parent.Replies = append(parent.Replies, comment)
+			continue
+		}
+		roots = append(roots, comment)
+	}
+
+	return roots
+}
@@ -0,0 +1,174 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// moreChildrenAnswer answers morechildren requests with a comment per requested id, the given parents nesting them;
+// comments without a parent hang off the submission. Ids mapped to stubs are answered with those stubs instead.
+func moreChildrenAnswer(parents map[string]string, stubs map[string]*MoreComments) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		var things []interface{}
+		for _, id := range strings.Split(r.URL.Query().Get("children"), ",") {
+			if stub, ok := stubs[id]; ok {
+				things = append(things, map[string]interface{}{"kind": "more", "data": stub})
+				continue
+			}
+
+			parent, ok := parents[id]
+			if !ok {
+				parent = "t3_abc"
+			}
+			things = append(things, map[string]interface{}{"kind": "t1", "data": map[string]interface{}{
+				"id": id, "name": "t1_" + id, "parent_id": parent, "link_id": "t3_abc", "body": "comment " + id, "replies": "",
+			}})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"json": map[string]interface{}{"errors": []interface{}{}, "data": map[string]interface{}{"things": things}}})
+	}
+}
+
+// batchSizes returns the no. of ids requested by each morechildren request the fake received
+func batchSizes(fake *fakeReddit) []int {
+	var sizes []int
+	for _, request := range fake.received() {
+		sizes = append(sizes, len(strings.Split(request.URL.Query().Get("children"), ",")))
+	}
+	return sizes
+}
+
+func commentIDs(prefix string, from int, to int) []string {
+	ids := make([]string, 0, to-from+1)
+	for index := from; index <= to; index++ {
+		ids = append(ids, fmt.Sprintf("%s%d", prefix, index))
+	}
+	return ids
+}
+
+func TestMoreChildren(t *testing.T) {
+
+	// even comments reply to the odd one before them, c201 replies to c1, across batches
+	parents := map[string]string{"c201": "t1_c1"}
+	for index := 2; index <= 250; index += 2 {
+		parents[fmt.Sprintf("c%d", index)] = fmt.Sprintf("t1_c%d", index-1)
+	}
+
+	fake := &fakeReddit{expiresIn: 3600, answer: moreChildrenAnswer(parents, nil)}
+	client := newTestClient(t, fake)
+
+	comments, err := client.MoreChildren("abc", TopComments, &MoreComments{Children: commentIDs("c", 1, 250)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sizes := batchSizes(fake); fmt.Sprint(sizes) != "[100 100 50]" {
+		t.Errorf("expected batches of 100, 100 and 50 ids, got %v", sizes)
+	}
+	if request := fake.received()[0]; request.URL.Query().Get("link_id") != "t3_abc" || request.URL.Query().Get("sort") != "top" {
+		t.Errorf("unexpected query %s", request.URL.RawQuery)
+	}
+
+	// 125 odd comments, less c201
+	if len(comments) != 124 {
+		t.Fatalf("expected 124 roots, got %d", len(comments))
+	}
+	first := comments[0]
+	if first.ID != "c1" || len(first.Replies) != 2 || first.Replies[0].ID != "c2" || first.Replies[1].ID != "c201" {
+		t.Errorf("expected c1 to hold c2 and c201, got %s holding %d replies", first.ID, len(first.Replies))
+	}
+	if first.Replies[1].Replies[0].ID != "c202" {
+		t.Errorf("expected c202 nested under c201")
+	}
+}
+
+func TestMoreChildrenErrors(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, answer: func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"json":{"errors":[["TOO_MANY_REQUESTS","slow down"]]}}`)
+	}}
+	client := newTestClient(t, fake)
+
+	if _, err := client.MoreChildren("abc", TopComments, nil); err == nil {
+		t.Error("expected an error for a nil stub")
+	}
+	if _, err := client.MoreChildren("abc", TopComments, &MoreComments{Children: []string{"c1"}}); err == nil || !strings.Contains(err.Error(), "slow down") {
+		t.Errorf("expected the error reported by Reddit, got %v", err)
+	}
+}
+
+func TestExpandAll(t *testing.T) {
+
+	stubs := map[string]*MoreComments{"deep": {ID: "deep", Name: "t1_deep", ParentID: "t1_x1", Children: []string{"d1", "d2"}}}
+	parents := map[string]string{"d1": "t1_x1", "d2": "t1_d1"}
+
+	tree := func() []*Comment {
+		return []*Comment{
+			{ID: "top", Name: "t1_top", Replies: []*Comment{
+				{ID: "m1", ParentID: "t1_top", More: &MoreComments{ID: "m1", ParentID: "t1_top", Children: append(commentIDs("a", 1, 150), "x1", "deep")}},
+			}},
+			{ID: "m2", More: &MoreComments{ID: "m2", Children: commentIDs("b", 1, 5)}},
+			// stubs without ids link to deeper threads, they are kept as they are
+			{ID: "_", More: &MoreComments{ID: "_", ParentID: "t1_top"}},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		maxRequests int
+		batches     string
+		// roots and replies of top after the expansion, the deep stub expanding under x1
+		roots   int
+		replies int
+		// ids left in the stubs
+		left map[string]int
+	}{
+		{"no budget", 0, "[]", 3, 1, map[string]int{"m1": 152, "m2": 5}},
+		{"partial", 1, "[100]", 3, 101, map[string]int{"m1": 52, "m2": 5}},
+		{"first stub", 3, "[100 52 2]", 3, 151, map[string]int{"m2": 5}},
+		{"everything", 10, "[100 52 2 5]", 7, 151, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &fakeReddit{expiresIn: 3600, answer: moreChildrenAnswer(parents, stubs)}
+			client := newTestClient(t, fake)
+
+			expanded, err := client.ExpandAll("abc", TopComments, tree(), test.maxRequests)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if batches := fmt.Sprint(batchSizes(fake)); batches != test.batches {
+				t.Errorf("expected batches of %s ids, got %s", test.batches, batches)
+			}
+			if len(expanded) != test.roots || len(expanded[0].Replies) != test.replies {
+				t.Fatalf("expected %d roots and %d replies, got %d and %d", test.roots, test.replies, len(expanded), len(expanded[0].Replies))
+			}
+
+			left := make(map[string]int)
+			var walk func([]*Comment)
+			walk = func(comments []*Comment) {
+				for _, comment := range comments {
+					if comment.IsMore() && len(comment.More.Children) > 0 {
+						left[comment.ID] = len(comment.More.Children)
+					}
+					if comment.ID == "d2" && comment.ParentID != "t1_d1" {
+						t.Errorf("d2 is not a reply to d1")
+					}
+					walk(comment.Replies)
+				}
+			}
+			walk(expanded)
+			if fmt.Sprint(left) != fmt.Sprint(test.left) {
+				t.Errorf("expected %v ids left in stubs, got %v", test.left, left)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var multiNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_]{1,49}$`)
+
+// MultisOf returns the public multireddits of the given user
+func (c *ReadOnlyRedditClient) MultisOf(username string) ([]*Multireddit, error) {
+
+	if err := validateAuthor(username); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/api/multi/user/%s?raw_json=1", QueryURL, username)
+
+	var response []struct {
+		Kind string
+		Data *Multireddit
+	}
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, err
+	}
+
+	multis := make([]*Multireddit, 0, len(response))
+	for _, multi := range response {
+		if multi.Data != nil {
+			multis = append(multis, multi.Data)
+		}
+	}
+
+	return multis, nil
+}
+
+// SubmissionsToMulti returns the submissions to the given multireddit of the given user, considering popularity sort,
+// age sort, and listing options
+func (c *ReadOnlyRedditClient) SubmissionsToMulti(username, multiName string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error) {
+
+	if err := validateAuthor(username); err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateMultiName(multiName); err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := age.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("t", string(age))
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/user/%s/m/%s/%s?%v", QueryURL, username, multiName, sort, queryParams.Encode())
+
+	response := new(listing)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, nil, err
+	}
+
+	submissions, err := decodeSubmissions(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submissions, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// SubredditNames returns the names of the subreddits of the multireddit
+func (m *Multireddit) SubredditNames() []string {
+	names := make([]string, len(m.Subreddits))
+	for index, subreddit := range m.Subreddits {
+		names[index] = subreddit.Name
+	}
+	return names
+}
+
+func validateMultiName(multiName string) error {
+
+	if len(multiName) == 0 {
+		return errors.New("multireddit name cannot be null nor empty")
+	}
+
+	if !multiNamePattern.MatchString(multiName) {
+		return fmt.Errorf("invalid multireddit name: %q", multiName)
+	}
+
+	return nil
+}
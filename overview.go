@@ -0,0 +1,223 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// CommentKind and SubmissionKind specify the kinds of things a Thing can hold
+const (
+	CommentKind    = "t1"
+	SubmissionKind = "t3"
+)
+
+// Thing represents either a submission or a comment, as found in mixed listings. Kind tells which field is set.
+type Thing struct {
+	Kind       string
+	Submission *Submission
+	Comment    *Comment
+}
+
+// Created returns the creation time of the thing, in seconds since the epoch, UTC
+func (t *Thing) Created() float64 {
+	switch {
+	case t.Submission != nil:
+		return t.Submission.CreatedUTC
+	case t.Comment != nil:
+		return t.Comment.CreatedUTC
+	}
+	return 0
+}
+
+// OverviewOf returns the submissions and comments of the given author, interleaved, considering popularity sort, age
+// sort, and listing options
+func (c *ReadOnlyRedditClient) OverviewOf(author string, sort PopularitySort, age AgeSort, params ListingOptions) ([]*Thing, *SliceInfo, error) {
+
+	if err := validateAuthor(author); err != nil {
+		return nil, nil, err
+	}
+
+	if err := sort.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := age.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if params.Limit > 100 && c.logger != nil {
+		c.logger.Debug("max limit is 100 results - should one need more, `after` or `before` for pagination")
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(sort) > 0 {
+		queryParams.Set("sort", string(sort))
+	}
+	queryParams.Set("t", string(age))
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/user/%s/overview?%v", QueryURL, author, queryParams.Encode())
+
+	var response listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	things, err := decodeThings(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return things, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// GildedOf returns the awarded submissions and comments of the given author, interleaved, considering listing options
+func (c *ReadOnlyRedditClient) GildedOf(author string, params ListingOptions) ([]*Thing, *SliceInfo, error) {
+
+	if err := validateAuthor(author); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/user/%s/gilded?%v", QueryURL, author, queryParams.Encode())
+
+	var response listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	things, err := decodeThings(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return things, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// decodeThings decodes submissions and comments, skipping any other kind
+func decodeThings(children []thing) ([]*Thing, error) {
+
+	things := make([]*Thing, 0, len(children))
+	for _, child := range children {
+		switch child.Kind {
+		case CommentKind:
+			comment := new(Comment)
+			if err := json.Unmarshal(child.Data, comment); err != nil {
+				return nil, err
+			}
+			things = append(things, &Thing{Kind: child.Kind, Comment: comment})
+		case SubmissionKind:
+			submission := new(Submission)
+			if err := json.Unmarshal(child.Data, submission); err != nil {
+				return nil, err
+			}
+			things = append(things, &Thing{Kind: child.Kind, Submission: submission})
+		}
+	}
+
+	return things, nil
+}
+
+// listingCap is the no. of items past which Reddit stops serving a listing
+const listingCap = 1000
+
+// HistoryTruncatedError is returned along with the history found when Reddit stopped listing the activity of an author
+// before the start of the requested window; Reddit lists only about the 1000 most recent items
+type HistoryTruncatedError struct {
+	Author string
+	// Oldest is the creation time of the oldest item listed
+	Oldest time.Time
+}
+
+func (e *HistoryTruncatedError) Error() string {
+	return fmt.Sprintf("history of %s is truncated, nothing older than %v is listed", e.Author, e.Oldest)
+}
+
+// HistoryOf returns the submissions and comments of the given author created within [from, to], newest first.
+// A zero to means up to now. Should the listing cap be reached before from, the history found is returned along
+// with a HistoryTruncatedError.
+func (c *ReadOnlyRedditClient) HistoryOf(author string, from time.Time, to time.Time) ([]*Thing, error) {
+
+	if err := validateAuthor(author); err != nil {
+		return nil, err
+	}
+
+	if !to.IsZero() && from.After(to) {
+		return nil, errors.New("from cannot be after to")
+	}
+
+	paged, pages := c.paged()
+	history := make([]*Thing, 0)
+	listed := 0
+	var oldest time.Time
+	after := ""
+	for {
+		things, slice, err := paged.OverviewOf(author, NewSubmissions, AllTime, ListingOptions{Limit: DefaultSliceSize, After: after})
+		if err == nil {
+			err = pages.retrieved(slice)
+		}
+
+		// a page hook ends the fetch with the history found so far, along with its error unless it stopped paging
+		var aborted error
+		stop := err == ErrStopPaging
+		if _, ok := err.(*PageHookError); ok {
+			stop, aborted = true, err
+		}
+		if stop && things == nil {
+			return history, aborted
+		}
+		if err != nil && !stop {
+			return nil, err
+		}
+
+		for _, thing := range things {
+			created := utcTime(thing.Created())
+			// submissions pinned to the profile are listed first, whatever their age
+			pinned := thing.Submission != nil && thing.Submission.Pinned
+			if created.Before(from) && !pinned {
+				return history, aborted
+			}
+			if created.Before(from) {
+				continue
+			}
+			if to.IsZero() || !created.After(to) {
+				history = append(history, thing)
+			}
+			if !pinned {
+				oldest = created
+			}
+		}
+		listed += len(things)
+
+		if stop {
+			return history, aborted
+		}
+
+		if len(things) == 0 || len(slice.After) == 0 {
+			if listed >= listingCap {
+				return history, &HistoryTruncatedError{Author: author, Oldest: oldest}
+			}
+			return history, nil
+		}
+
+		after = slice.After
+	}
+}
+
+// utcTime converts seconds since the epoch, as reported by Reddit, to a time
+func utcTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+}
@@ -0,0 +1,139 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// validator is implemented by option structs checking their own consistency before being encoded
+type validator interface {
+	validate() error
+}
+
+// encodeValues encodes the exported fields of an option struct as url query parameters, following their `url` tags.
+// A tag has the form `url:"name[,omitempty][,int]"`: fields tagged with "-" are skipped, omitempty skips zero values
+// and int encodes booleans as 1 or 0 instead of true or false. Pointers are encoded as the value they point to.
+func encodeValues(options interface{}) (url.Values, error) {
+
+	values := url.Values{}
+	if options == nil {
+		return values, nil
+	}
+
+	value := reflect.ValueOf(options)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return values, nil
+		}
+		value = value.Elem()
+	}
+
+	if v, ok := options.(validator); ok {
+		if err := v.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot encode %s as query parameters, expected a struct", value.Type())
+	}
+
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		tag := field.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts := parseTag(tag)
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		fieldValue := value.Field(i)
+		if opts.contains("omitempty") && isZero(fieldValue) {
+			continue
+		}
+
+		// pointers are encoded as the value they point to, nil ones as empty values
+		for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+			fieldValue = fieldValue.Elem()
+		}
+
+		encoded, err := encodeValue(fieldValue, opts)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode field %s: %v", field.Name, err)
+		}
+
+		values.Set(name, encoded)
+	}
+
+	return values, nil
+}
+
+type tagOptions []string
+
+func (o tagOptions) contains(option string) bool {
+	for _, candidate := range o {
+		if candidate == option {
+			return true
+		}
+	}
+	return false
+}
+
+func parseTag(tag string) (string, tagOptions) {
+	parts := strings.Split(tag, ",")
+	return parts[0], parts[1:]
+}
+
+func encodeValue(value reflect.Value, opts tagOptions) (string, error) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		return "", nil
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		if opts.contains("int") {
+			if value.Bool() {
+				return "1", nil
+			}
+			return "0", nil
+		}
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	}
+	return "", errors.New("unsupported type " + value.Type().String())
+}
+
+func isZero(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr:
+		return value.IsNil()
+	case reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package redditreadgo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type allKinds struct {
+	S          string  `url:"s"`
+	B          bool    `url:"b"`
+	BI         bool    `url:"bi,int"`
+	I          int     `url:"i"`
+	I8         int8    `url:"i8"`
+	U          uint64  `url:"u"`
+	F          float64 `url:"f"`
+	P          *int    `url:"p"`
+	PO         *int    `url:"po,omitempty"`
+	PS         *string `url:"ps,omitempty"`
+	Untagged   string
+	unexported string
+}
+
+type omitted struct {
+	S  string  `url:"s,omitempty"`
+	B  bool    `url:"b,omitempty"`
+	BI bool    `url:"bi,omitempty,int"`
+	I  int     `url:"i,omitempty"`
+	U  uint    `url:"u,omitempty"`
+	F  float32 `url:"f,omitempty"`
+	P  *int    `url:"p,omitempty"`
+}
+
+type skipped struct {
+	Kept    string   `url:"kept"`
+	Skipped []string `url:"-"`
+	Nested  omitted  `url:"-"`
+}
+
+type invalidOptions struct {
+	Value string `url:"value"`
+}
+
+func (invalidOptions) validate() error {
+	return errors.New("invalid options")
+}
+
+func TestEncodeValues(t *testing.T) {
+
+	zero, seven, name := 0, 7, "x y"
+
+	tests := []struct {
+		name     string
+		options  interface{}
+		expected string
+	}{
+		{"nil", nil, ""},
+		{"nil pointer", (*ListingOptions)(nil), ""},
+		{"pointer to struct", &CommentOptions{Depth: 2}, "depth=2"},
+		{"zero values", allKinds{}, "Untagged=&b=false&bi=0&f=0&i=0&i8=0&p=&s=&u=0"},
+		{"values", allKinds{S: "a b", B: true, BI: true, I: -4, I8: 8, U: 9, F: 1.5, P: &seven, PO: &seven, PS: &name, Untagged: "u", unexported: "no"},
+			"Untagged=u&b=true&bi=1&f=1.5&i=-4&i8=8&p=7&po=7&ps=x+y&s=a+b&u=9"},
+		{"omitempty skips zero values of each kind", omitted{}, ""},
+		{"omitempty keeps pointers to zero values", omitted{P: &zero}, "p=0"},
+		{"omitempty keeps other values", omitted{S: "s", B: true, BI: true, I: 1, U: 2, F: 0.25, P: &seven}, "b=true&bi=1&f=0.25&i=1&p=7&s=s&u=2"},
+		{"int encodes false as 0", struct {
+			B bool `url:"b,int"`
+		}{}, "b=0"},
+		{"dash skips the field", skipped{Kept: "k", Skipped: []string{"a"}}, "kept=k"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := encodeValues(test.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded := values.Encode(); encoded != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, encoded)
+			}
+		})
+	}
+}
+
+func TestEncodeValuesErrors(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		options interface{}
+		message string
+	}{
+		{"not a struct", 42, "expected a struct"},
+		{"slice field", struct {
+			S []string `url:"s"`
+		}{}, "cannot encode field S"},
+		{"map field", struct {
+			M map[string]string `url:"m"`
+		}{}, "cannot encode field M"},
+		{"struct field", struct {
+			L ListingOptions `url:"l"`
+		}{}, "cannot encode field L"},
+		{"validator", invalidOptions{Value: "v"}, "invalid options"},
+		{"listing options validator", ListingOptions{After: "t3_a", Before: "t3_b"}, "after and before"},
+		{"comment options validator", CommentOptions{Depth: -1}, "depth cannot be negative"},
+		{"context without a comment", CommentOptions{Context: 2}, "context requires a comment"},
+		{"invalid comment id", CommentOptions{Comment: "t1_c1/../x", Context: 2}, "invalid comment id"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := encodeValues(test.options)
+			if err == nil {
+				t.Fatalf("expected an error, got %q", values.Encode())
+			}
+			if !strings.Contains(err.Error(), test.message) {
+				t.Errorf("expected an error containing %q, got %v", test.message, err)
+			}
+		})
+	}
+}
+
+// TestEncodeValuesParity checks the option structs encode exactly as github.com/google/go-querystring, which
+// encodeValues replaced, encoded them
+func TestEncodeValuesParity(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		options  interface{}
+		expected string
+	}{
+		{"empty comment options", CommentOptions{}, ""},
+		{"comment options", CommentOptions{Depth: 3, Limit: 50, Comment: "c1", Context: 2, Truncate: 10}, "comment=c1&context=2&depth=3&limit=50&truncate=10"},
+		{"empty listing options", ListingOptions{}, ""},
+		{"listing options", ListingOptions{Region: "GLOBAL", Limit: 100, After: "t3_abc", Count: 25, IncludeSubredditDetail: true, Show: ShowAll},
+			"after=t3_abc&count=25&limit=100&q=GLOBAL&show=all&sr_detail=1"},
+		{"escaped listing options", ListingOptions{Before: "t3_a&b=c"}, "before=t3_a%26b%3Dc"},
+		{"empty search options", SearchOptions{}, ""},
+		{"search options", SearchOptions{Subreddit: "golang", Sort: NewResults, Age: ThisWeek, Listing: ListingOptions{Limit: 5}}, "sort=new&t=week"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := encodeValues(test.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if encoded := values.Encode(); encoded != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, encoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SubmissionByURL returns the submission behind the given reddit.com permalink, redd.it short link or /s/ share
+// link, along with its comment tree when comment options are given. Share links are resolved with an extra request.
+func (c *ReadOnlyRedditClient) SubmissionByURL(permalinkOrShareURL string, opts *CommentOptions) (*Submission, []*Comment, error) {
+
+	id, err := submissionIDFromURL(permalinkOrShareURL)
+	if err == errShareLink {
+		var resolved string
+		if resolved, err = c.resolveShareLink(permalinkOrShareURL); err == nil {
+			id, err = submissionIDFromURL(resolved)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts == nil {
+		submission, err := c.Submission(id)
+		return submission, nil, err
+	}
+
+	return c.SubmissionWithComments(id, *opts)
+}
+
+var errShareLink = errors.New("share link")
+
+// submissionIDFromURL extracts the submission id from a permalink or short link, failing with errShareLink for
+// share links, which must be resolved first
+func submissionIDFromURL(rawURL string) (string, error) {
+
+	parsed, err := parseRedditURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if parsed.Host == "redd.it" {
+		return normalizeSubmissionID(segments[0])
+	}
+
+	for index, segment := range segments {
+		switch {
+		case segment == "comments" && index+1 < len(segments):
+			return normalizeSubmissionID(segments[index+1])
+		case segment == "s" && index+1 < len(segments) && index > 0:
+			return "", errShareLink
+		}
+	}
+
+	return "", fmt.Errorf("not a submission url: %q", rawURL)
+}
+
+// parseRedditURL parses a URL, with or without scheme, of a reddit.com or redd.it host
+func parseRedditURL(rawURL string) (*url.URL, error) {
+
+	if len(rawURL) == 0 {
+		return nil, errors.New("url cannot be null nor empty")
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %q: %v", rawURL, err)
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host != "redd.it" && host != "reddit.com" && !strings.HasSuffix(host, ".reddit.com") {
+		return nil, fmt.Errorf("not a reddit url: %q", rawURL)
+	}
+	parsed.Host = host
+
+	return parsed, nil
+}
+
+// resolveShareLink returns the permalink a share link redirects to. The link is resolved through the API host, and
+// so through the gateway, like every other request.
+func (c *ReadOnlyRedditClient) resolveShareLink(shareURL string) (resolved string, err error) {
+
+	if c == nil || c.httpClient == nil || c.tokens == nil {
+		return "", errors.New("client is not logged in, use NewReadOnlyRedditClient")
+	}
+
+	parsed, err := parseRedditURL(shareURL)
+	if err != nil {
+		return "", err
+	}
+
+	queryURL, err := url.Parse(QueryURL + parsed.EscapedPath())
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := c.requestContext()
+	defer cancel()
+
+	request, userAgent, err := c.prepareRequest(ctx, "HEAD", queryURL.String())
+	if err != nil {
+		return "", err
+	}
+
+	// the redirect is the answer, it must not be followed
+	httpClient := *c.httpClient
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	start := time.Now()
+	var response *http.Response
+	defer func() {
+		c.completed("HEAD", queryURL.String(), start, userAgent, response, 0, "", err)
+	}()
+
+	response, err = httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	c.rateLimits.update(response, time.Now())
+
+	location := response.Header.Get("Location")
+	if response.StatusCode < 300 || response.StatusCode > 399 || len(location) == 0 {
+		return "", fmt.Errorf("share link %s did not redirect, status: %v", shareURL, response.Status)
+	}
+
+	// relative locations are relative to Reddit, not to the gateway
+	target, err := queryURL.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("share link %s redirected to an invalid url: %v", shareURL, err)
+	}
+
+	return target.String(), nil
+}
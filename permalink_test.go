@@ -0,0 +1,76 @@
+package redditreadgo
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSubmissionByShareURL(t *testing.T) {
+
+	fake := &fakeReddit{
+		expiresIn: 3600,
+		pages:     map[string]string{"/api/info": infoPage("abc123")},
+		redirects: map[string]string{"/r/golang/s/AbCdEf": "https://www.reddit.com/r/golang/comments/abc123/title/"},
+	}
+	client := newTestClient(t, fake)
+
+	client.UserAgents([]string{"agent-1", "agent-2"})
+	quota := NewQuotaManager()
+	client.Quota(quota)
+	audit := &auditRecorder{}
+	client.Audit(audit)
+	bus := NewEventBus()
+	var fetched []PageFetched
+	bus.Subscribe(func(event Event) {
+		if page, ok := event.(PageFetched); ok {
+			fetched = append(fetched, page)
+		}
+	})
+	client.Events(bus)
+
+	submission, _, err := client.SubmissionByURL("https://www.reddit.com/r/golang/s/AbCdEf", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if submission.ID != "abc123" {
+		t.Errorf("expected submission abc123, got %s", submission.ID)
+	}
+
+	// the share link is resolved through the gateway, with the next user agent of the rotation
+	requests := fake.received()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if request := requests[0]; request.Method != "HEAD" || request.URL.Path != "/r/golang/s/AbCdEf" || request.UserAgent() != "agent-1" {
+		t.Errorf("unexpected share link request: %s %s by %s", request.Method, request.URL.Path, request.UserAgent())
+	}
+	if request := requests[1]; request.Method != "GET" || request.UserAgent() != "agent-2" {
+		t.Errorf("unexpected submission request: %s %s by %s", request.Method, request.URL.Path, request.UserAgent())
+	}
+
+	if usage := quota.Usage(DefaultConsumer); usage.Used != 2 {
+		t.Errorf("expected both requests to be charged, %d were", usage.Used)
+	}
+
+	if len(audit.entries) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", len(audit.entries))
+	}
+	entry := audit.entries[0]
+	if entry.Method != "HEAD" || entry.URL != QueryURL+"/r/golang/s/AbCdEf" || entry.StatusCode != http.StatusMovedPermanently {
+		t.Errorf("unexpected audit entry %+v", entry)
+	}
+
+	if len(fetched) != 2 || fetched[0].UserAgent != "agent-1" || fetched[0].StatusCode != http.StatusMovedPermanently {
+		t.Errorf("unexpected events %+v", fetched)
+	}
+}
+
+func TestShareLinkWithoutRedirect(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/s/AbCdEf": "{}"}}
+	client := newTestClient(t, fake)
+
+	if _, _, err := client.SubmissionByURL("https://www.reddit.com/r/golang/s/AbCdEf", nil); err == nil {
+		t.Error("expected an error for a share link not redirecting")
+	}
+}
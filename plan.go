@@ -0,0 +1,143 @@
+package redditreadgo
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitWindow specifies the length of the window Reddit enforces its API rate limit over
+const RateLimitWindow = 10 * time.Minute
+
+// Plan estimates how long the given no. of requests would take under the current throttle, the remaining quota of
+// the consumer and the rate limit Reddit last reported. The estimate is a lower bound, ignoring response times;
+// limiters other than *rate.Limiter, as set by Throttle, are not accounted for. A QuotaExceededError is returned if
+// the quota can never allow that many requests.
+func (c *ReadOnlyRedditClient) Plan(requests int) (time.Duration, error) {
+
+	if requests < 0 {
+		return 0, errors.New("requests cannot be negative")
+	}
+
+	if c == nil || requests == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	estimate := c.throttleDuration(requests)
+
+	quotaWait, err := c.quotaWait(requests, now)
+	if err != nil {
+		return 0, err
+	}
+	if quotaWait > estimate {
+		estimate = quotaWait
+	}
+
+	if rateLimitWait := c.rateLimits.wait(requests, now); rateLimitWait > estimate {
+		estimate = rateLimitWait
+	}
+
+	return estimate, nil
+}
+
+// throttleDuration returns the time the throttle needs to let the given no. of requests through
+func (c *ReadOnlyRedditClient) throttleDuration(requests int) time.Duration {
+
+	limiter, ok := c.throttle.(*rate.Limiter)
+	if !ok || limiter.Limit() == rate.Inf || limiter.Limit() <= 0 {
+		return 0
+	}
+
+	throttled := requests - limiter.Burst()
+	if throttled <= 0 {
+		return 0
+	}
+	return time.Duration(float64(throttled) / float64(limiter.Limit()) * float64(time.Second))
+}
+
+// quotaWait returns the time the consumer must wait for its budget to allow the given no. of requests
+func (c *ReadOnlyRedditClient) quotaWait(requests int, now time.Time) (time.Duration, error) {
+
+	if c.quota == nil {
+		return 0, nil
+	}
+
+	consumer := c.consumer
+	if len(consumer) == 0 {
+		consumer = DefaultConsumer
+	}
+
+	usage := c.quota.Usage(consumer)
+	if usage.Budget <= 0 || requests <= usage.Budget-usage.Used {
+		return 0, nil
+	}
+
+	if usage.Window <= 0 {
+		return 0, &QuotaExceededError{Consumer: consumer, Budget: usage.Budget}
+	}
+
+	reset := c.quota.reset(consumer).Sub(now)
+	windows := int(math.Ceil(float64(requests-(usage.Budget-usage.Used))/float64(usage.Budget))) - 1
+	return reset + time.Duration(windows)*usage.Window, nil
+}
+
+// rateLimitState keeps the rate limit Reddit reported last. A nil value knows nothing.
+type rateLimitState struct {
+	mutex     sync.Mutex
+	known     bool
+	used      float64
+	remaining float64
+	reset     time.Time
+}
+
+// update records the rate limit reported by the given response, if any
+func (s *rateLimitState) update(response *http.Response, now time.Time) {
+
+	if s == nil || response == nil {
+		return
+	}
+
+	remaining, err := strconv.ParseFloat(response.Header.Get("X-Ratelimit-Remaining"), 64)
+	if err != nil {
+		return
+	}
+	used, _ := strconv.ParseFloat(response.Header.Get("X-Ratelimit-Used"), 64)
+	reset, _ := strconv.ParseFloat(response.Header.Get("X-Ratelimit-Reset"), 64)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.known = true
+	s.used = used
+	s.remaining = remaining
+	s.reset = now.Add(time.Duration(reset * float64(time.Second)))
+}
+
+// wait returns the time to wait for Reddit's rate limit to allow the given no. of requests
+func (s *rateLimitState) wait(requests int, now time.Time) time.Duration {
+
+	if s == nil {
+		return 0
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.known || !now.Before(s.reset) || float64(requests) <= s.remaining {
+		return 0
+	}
+
+	capacity := s.used + s.remaining
+	if capacity <= 0 {
+		return s.reset.Sub(now)
+	}
+
+	windows := int(math.Ceil((float64(requests)-s.remaining)/capacity)) - 1
+	return s.reset.Sub(now) + time.Duration(windows)*RateLimitWindow
+}
@@ -0,0 +1,137 @@
+package redditreadgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type noopLimiter struct{}
+
+func (noopLimiter) Wait(context.Context) error { return nil }
+
+func TestPlan(t *testing.T) {
+
+	hourly := NewQuotaManager()
+	hourly.SetBudget(DefaultConsumer, 5, time.Hour)
+	once := NewQuotaManager()
+	once.SetBudget(DefaultConsumer, 5, 0)
+
+	tests := []struct {
+		name     string
+		client   *ReadOnlyRedditClient
+		requests int
+		expected time.Duration
+		fails    bool
+	}{
+		{"nil client", nil, 10, 0, false},
+		{"no requests", &ReadOnlyRedditClient{throttle: rate.NewLimiter(rate.Every(time.Second), 1)}, 0, 0, false},
+		{"negative requests", &ReadOnlyRedditClient{}, -1, 0, true},
+		{"unthrottled", &ReadOnlyRedditClient{}, 100, 0, false},
+		{"throttled", &ReadOnlyRedditClient{throttle: rate.NewLimiter(rate.Every(time.Second), 1)}, 11, 10 * time.Second, false},
+		{"within burst", &ReadOnlyRedditClient{throttle: rate.NewLimiter(rate.Every(time.Second), 5)}, 5, 0, false},
+		{"unlimited throttle", &ReadOnlyRedditClient{throttle: rate.NewLimiter(rate.Inf, 1)}, 100, 0, false},
+		{"other limiter", &ReadOnlyRedditClient{throttle: noopLimiter{}}, 100, 0, false},
+		{"quota", &ReadOnlyRedditClient{quota: hourly, throttle: rate.NewLimiter(rate.Every(time.Second), 1)}, 6, time.Hour, false},
+		{"throttle beyond quota", &ReadOnlyRedditClient{quota: hourly, throttle: rate.NewLimiter(rate.Every(time.Hour), 1)}, 5, 4 * time.Hour, false},
+		{"quota never replenished", &ReadOnlyRedditClient{quota: once}, 6, 0, true},
+		{"rate limit", &ReadOnlyRedditClient{rateLimits: &rateLimitState{known: true, used: 599, remaining: 1, reset: time.Now().Add(time.Hour)}}, 2, time.Hour, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			estimate, err := test.client.Plan(test.requests)
+			if test.fails {
+				if err == nil {
+					t.Errorf("expected an error, got %v", estimate)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// estimates depending on the clock may be a little short of the expected duration
+			if estimate > test.expected || estimate < test.expected-time.Second {
+				t.Errorf("expected %v, got %v", test.expected, estimate)
+			}
+		})
+	}
+}
+
+func TestQuotaWait(t *testing.T) {
+
+	now := time.Now()
+	manager := NewQuotaManager()
+	// the window of every consumer started 10 minutes ago, resetting in 50
+	setQuota := func(consumer string, budget, used int, window time.Duration) {
+		manager.quotas[consumer] = &quota{usage: QuotaUsage{Consumer: consumer, Budget: budget, Used: used, Window: window}, windowStart: now.Add(-10 * time.Minute)}
+	}
+	setQuota(DefaultConsumer, 10, 4, time.Hour)
+	setQuota("unlimited", 0, 400, time.Hour)
+	setQuota("once", 10, 4, 0)
+
+	tests := []struct {
+		consumer string
+		requests int
+		expected time.Duration
+		fails    bool
+	}{
+		{"", 6, 0, false},
+		{"", 7, 50 * time.Minute, false},
+		{DefaultConsumer, 16, 50 * time.Minute, false},
+		{DefaultConsumer, 17, 50*time.Minute + time.Hour, false},
+		{DefaultConsumer, 26, 50*time.Minute + time.Hour, false},
+		{DefaultConsumer, 27, 50*time.Minute + 2*time.Hour, false},
+		{"unlimited", 1000, 0, false},
+		{"once", 6, 0, false},
+		{"once", 7, 0, true},
+	}
+
+	for _, test := range tests {
+		client := &ReadOnlyRedditClient{quota: manager, consumer: test.consumer}
+		wait, err := client.quotaWait(test.requests, now)
+		if test.fails {
+			if _, ok := err.(*QuotaExceededError); !ok {
+				t.Errorf("%q, %d requests: expected a QuotaExceededError, got %v", test.consumer, test.requests, err)
+			}
+			continue
+		}
+		if err != nil || wait != test.expected {
+			t.Errorf("%q, %d requests: expected %v, got %v and %v", test.consumer, test.requests, test.expected, wait, err)
+		}
+	}
+
+	if wait, err := (&ReadOnlyRedditClient{}).quotaWait(1000, now); wait != 0 || err != nil {
+		t.Errorf("expected no wait without a quota manager, got %v and %v", wait, err)
+	}
+}
+
+func TestRateLimitWait(t *testing.T) {
+
+	now := time.Now()
+	reset := now.Add(5 * time.Minute)
+
+	tests := []struct {
+		name     string
+		state    *rateLimitState
+		requests int
+		expected time.Duration
+	}{
+		{"nil", nil, 1000, 0},
+		{"unknown", &rateLimitState{}, 1000, 0},
+		{"window over", &rateLimitState{known: true, used: 600, remaining: 0, reset: now}, 1000, 0},
+		{"remaining", &rateLimitState{known: true, used: 590, remaining: 10, reset: reset}, 10, 0},
+		{"next window", &rateLimitState{known: true, used: 590, remaining: 10, reset: reset}, 11, 5 * time.Minute},
+		{"end of next window", &rateLimitState{known: true, used: 590, remaining: 10, reset: reset}, 610, 5 * time.Minute},
+		{"later window", &rateLimitState{known: true, used: 590, remaining: 10, reset: reset}, 611, 5*time.Minute + RateLimitWindow},
+		{"no capacity", &rateLimitState{known: true, reset: reset}, 1000, 5 * time.Minute},
+	}
+
+	for _, test := range tests {
+		if wait := test.state.wait(test.requests, now); wait != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, wait)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package redditreadgo
+
+// SafePreview returns the widest preview rendition not wider than maxWidth (any width if maxWidth is 0) which is safe
+// to display in a SFW context. For NSFW or spoiler submissions only the blurred variants are considered; false is
+// returned when no suitable rendition exists.
+func (s *Submission) SafePreview(maxWidth int) (*PreviewSource, bool) {
+
+	if s == nil || s.Preview == nil {
+		return nil, false
+	}
+
+	for _, image := range s.Preview.Images {
+		if image == nil {
+			continue
+		}
+
+		candidate := image
+		if s.Over18 || s.Spoiler {
+			candidate = image.Variants.NSFW
+			if candidate == nil || s.Spoiler {
+				candidate = image.Variants.Obfuscated
+			}
+		}
+
+		if source := candidate.largest(maxWidth); source != nil {
+			return source, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsThumbnailSafe reports whether Thumbnail is an actual image URL which is safe to display in a SFW context
+func (s *Submission) IsThumbnailSafe() bool {
+	if s == nil || s.Over18 || s.Spoiler {
+		return false
+	}
+
+	switch s.Thumbnail {
+	case "", "self", "default", "nsfw", "spoiler", "image":
+		return false
+	}
+	return true
+}
+
+func (i *PreviewImage) largest(maxWidth int) *PreviewSource {
+
+	if i == nil {
+		return nil
+	}
+
+	var best *PreviewSource
+	candidates := append([]*PreviewSource{i.Source}, i.Resolutions...)
+	for _, candidate := range candidates {
+		if candidate == nil || len(candidate.URL) == 0 {
+			continue
+		}
+		if maxWidth > 0 && candidate.Width > maxWidth {
+			continue
+		}
+		if best == nil || candidate.Width > best.Width {
+			best = candidate
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,82 @@
+package redditreadgo
+
+import "testing"
+
+// previewImage returns an image with renditions of the given widths, their URLs prefixed with the given name
+func previewImage(name string, widths ...int) *PreviewImage {
+
+	image := &PreviewImage{Source: &PreviewSource{URL: name + "-source", Width: widths[0]}}
+	for _, width := range widths[1:] {
+		image.Resolutions = append(image.Resolutions, &PreviewSource{URL: name + "-resolution", Width: width})
+	}
+	return image
+}
+
+func TestSafePreview(t *testing.T) {
+
+	withVariants := func(nsfw, obfuscated *PreviewImage) *Preview {
+		image := previewImage("original", 1080, 320, 640)
+		image.Variants = PreviewVariants{NSFW: nsfw, Obfuscated: obfuscated}
+		return &Preview{Images: []*PreviewImage{nil, image}}
+	}
+
+	tests := []struct {
+		name       string
+		submission *Submission
+		maxWidth   int
+		url        string
+		width      int
+	}{
+		{"widest", &Submission{Preview: withVariants(nil, nil)}, 0, "original-source", 1080},
+		{"max width", &Submission{Preview: withVariants(nil, nil)}, 700, "original-resolution", 640},
+		{"max width below every rendition", &Submission{Preview: withVariants(nil, nil)}, 100, "", 0},
+		{"over 18", &Submission{Over18: true, Preview: withVariants(previewImage("nsfw", 1080, 640), previewImage("obfuscated", 1080))}, 0, "nsfw-source", 1080},
+		{"over 18 without nsfw variant", &Submission{Over18: true, Preview: withVariants(nil, previewImage("obfuscated", 1080, 320))}, 640, "obfuscated-resolution", 320},
+		{"over 18 without variants", &Submission{Over18: true, Preview: withVariants(nil, nil)}, 0, "", 0},
+		{"spoiler", &Submission{Spoiler: true, Preview: withVariants(previewImage("nsfw", 1080), previewImage("obfuscated", 1080))}, 0, "obfuscated-source", 1080},
+		{"spoiler over 18", &Submission{Spoiler: true, Over18: true, Preview: withVariants(previewImage("nsfw", 1080), previewImage("obfuscated", 1080))}, 0, "obfuscated-source", 1080},
+		{"spoiler without obfuscated variant", &Submission{Spoiler: true, Preview: withVariants(previewImage("nsfw", 1080), nil)}, 0, "", 0},
+		{"no preview", &Submission{}, 0, "", 0},
+		{"nil submission", nil, 0, "", 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source, ok := test.submission.SafePreview(test.maxWidth)
+			if len(test.url) == 0 {
+				if ok || source != nil {
+					t.Errorf("expected no preview, got %+v", source)
+				}
+				return
+			}
+			if !ok || source == nil || source.URL != test.url || source.Width != test.width {
+				t.Errorf("expected %s at %d, got %+v", test.url, test.width, source)
+			}
+		})
+	}
+}
+
+func TestIsThumbnailSafe(t *testing.T) {
+
+	tests := []struct {
+		submission *Submission
+		expected   bool
+	}{
+		{&Submission{Thumbnail: "https://b.thumbs.redditmedia.com/a.jpg"}, true},
+		{&Submission{Thumbnail: "https://b.thumbs.redditmedia.com/a.jpg", Over18: true}, false},
+		{&Submission{Thumbnail: "https://b.thumbs.redditmedia.com/a.jpg", Spoiler: true}, false},
+		{&Submission{Thumbnail: ""}, false},
+		{&Submission{Thumbnail: "self"}, false},
+		{&Submission{Thumbnail: "default"}, false},
+		{&Submission{Thumbnail: "nsfw"}, false},
+		{&Submission{Thumbnail: "spoiler"}, false},
+		{&Submission{Thumbnail: "image"}, false},
+		{nil, false},
+	}
+
+	for _, test := range tests {
+		if safe := test.submission.IsThumbnailSafe(); safe != test.expected {
+			t.Errorf("IsThumbnailSafe(%+v): expected %v, got %v", test.submission, test.expected, safe)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package redditreadgo
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultConsumer is the consumer charged for requests made by a client not derived via Consumer
+const DefaultConsumer = "default"
+
+// QuotaExceededError is returned when a consumer has used up its request budget
+type QuotaExceededError struct {
+	Consumer string
+	Budget   int
+	// Reset is the moment the budget is replenished, zero if it never is
+	Reset time.Time
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded: consumer %s used its budget of %d requests", e.Consumer, e.Budget)
+}
+
+// QuotaExceeded is emitted when a request is rejected because its consumer used up its budget
+type QuotaExceeded struct {
+	At       time.Time
+	Consumer string
+	Budget   int
+}
+
+// Time returns the moment the event occurred
+func (e QuotaExceeded) Time() time.Time { return e.At }
+
+// QuotaUsage represents the accounting of a single consumer
+type QuotaUsage struct {
+	Consumer string
+	// Budget is the number of requests allowed per window, 0 meaning unlimited
+	Budget int
+	Window time.Duration
+	// Used is the number of requests made in the current window
+	Used int
+	// Total is the number of requests made since the consumer was first seen
+	Total int
+	// Rejected is the number of requests refused because the budget was used up
+	Rejected int
+}
+
+type quota struct {
+	usage       QuotaUsage
+	windowStart time.Time
+}
+
+// QuotaManager assigns request budgets to the consumers sharing a client, e.g. interactive vs batch work.
+// It is safe for concurrent use.
+type QuotaManager struct {
+	mutex  sync.Mutex
+	quotas map[string]*quota
+}
+
+// NewQuotaManager creates a quota manager without any budget; consumers are unlimited until SetBudget is called
+func NewQuotaManager() *QuotaManager {
+	return &QuotaManager{quotas: make(map[string]*quota)}
+}
+
+// SetBudget allows the consumer the given number of requests per window. A budget of 0 removes the limit,
+// a window of 0 means the budget is never replenished.
+func (q *QuotaManager) SetBudget(consumer string, requests int, window time.Duration) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry := q.get(consumer)
+	entry.usage.Budget = requests
+	entry.usage.Window = window
+	entry.usage.Used = 0
+	entry.windowStart = time.Now()
+}
+
+// Usage returns the accounting of the given consumer
+func (q *QuotaManager) Usage(consumer string) QuotaUsage {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry := q.get(consumer)
+	q.roll(entry, time.Now())
+	return entry.usage
+}
+
+// Usages returns the accounting of all known consumers, sorted by name
+func (q *QuotaManager) Usages() []QuotaUsage {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := time.Now()
+	usages := make([]QuotaUsage, 0, len(q.quotas))
+	for _, entry := range q.quotas {
+		q.roll(entry, now)
+		usages = append(usages, entry.usage)
+	}
+
+	sort.Slice(usages, func(i, j int) bool { return usages[i].Consumer < usages[j].Consumer })
+	return usages
+}
+
+// acquire charges one request to the consumer, failing if its budget is used up
+func (q *QuotaManager) acquire(consumer string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry := q.get(consumer)
+	q.roll(entry, time.Now())
+
+	if entry.usage.Budget > 0 && entry.usage.Used >= entry.usage.Budget {
+		entry.usage.Rejected++
+		err := &QuotaExceededError{Consumer: consumer, Budget: entry.usage.Budget}
+		if entry.usage.Window > 0 {
+			err.Reset = entry.windowStart.Add(entry.usage.Window)
+		}
+		return err
+	}
+
+	entry.usage.Used++
+	entry.usage.Total++
+	return nil
+}
+
+// reset returns the moment the budget of the consumer is replenished, zero if it never is
+func (q *QuotaManager) reset(consumer string) time.Time {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	entry := q.get(consumer)
+	q.roll(entry, time.Now())
+	if entry.usage.Window <= 0 {
+		return time.Time{}
+	}
+	return entry.windowStart.Add(entry.usage.Window)
+}
+
+func (q *QuotaManager) get(consumer string) *quota {
+	if q.quotas == nil {
+		q.quotas = make(map[string]*quota)
+	}
+
+	entry, ok := q.quotas[consumer]
+	if !ok {
+		entry = &quota{usage: QuotaUsage{Consumer: consumer}, windowStart: time.Now()}
+		q.quotas[consumer] = entry
+	}
+	return entry
+}
+
+func (q *QuotaManager) roll(entry *quota, now time.Time) {
+	if entry.usage.Window > 0 && now.Sub(entry.windowStart) >= entry.usage.Window {
+		entry.usage.Used = 0
+		entry.windowStart = now
+	}
+}
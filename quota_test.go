@@ -0,0 +1,129 @@
+package redditreadgo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuotaBudget(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/user/spez/about": `{"kind":"t2","data":{"name":"spez"}}`}}
+	client := newTestClient(t, fake)
+
+	start := time.Now()
+	quota := NewQuotaManager()
+	quota.SetBudget(DefaultConsumer, 2, time.Hour)
+	client.Quota(quota)
+
+	bus := NewEventBus()
+	var exceeded []QuotaExceeded
+	bus.Subscribe(func(event Event) {
+		if event, ok := event.(QuotaExceeded); ok {
+			exceeded = append(exceeded, event)
+		}
+	})
+	client.Events(bus)
+
+	for request := 0; request < 2; request++ {
+		if _, err := client.AboutUser("spez"); err != nil {
+			t.Fatalf("unexpected error within the budget: %v", err)
+		}
+	}
+
+	_, err := client.AboutUser("spez")
+	quotaErr, ok := err.(*QuotaExceededError)
+	if !ok {
+		t.Fatalf("expected a QuotaExceededError, got %v", err)
+	}
+	if quotaErr.Consumer != DefaultConsumer || quotaErr.Budget != 2 {
+		t.Errorf("unexpected error %+v", quotaErr)
+	}
+	if reset := quotaErr.Reset; reset.Before(start.Add(time.Hour)) || reset.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expected the budget to be replenished an hour after it was set, got %v", reset)
+	}
+
+	if requests := len(fake.received()); requests != 2 {
+		t.Errorf("expected the rejected request not to be sent, %d requests were", requests)
+	}
+	if len(exceeded) != 1 || exceeded[0].Consumer != DefaultConsumer || exceeded[0].Budget != 2 {
+		t.Errorf("expected a single QuotaExceeded event, got %+v", exceeded)
+	}
+
+	usage := quota.Usage(DefaultConsumer)
+	if usage.Used != 2 || usage.Total != 2 || usage.Rejected != 1 || usage.Budget != 2 || usage.Window != time.Hour {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+}
+
+func TestQuotaWindowRollOver(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/user/spez/about": `{"kind":"t2","data":{"name":"spez"}}`}}
+	client := newTestClient(t, fake)
+
+	quota := NewQuotaManager()
+	quota.SetBudget(DefaultConsumer, 1, 50*time.Millisecond)
+	client.Quota(quota)
+
+	if _, err := client.AboutUser("spez"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.AboutUser("spez"); err == nil {
+		t.Fatal("expected the budget to be used up")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	if _, err := client.AboutUser("spez"); err != nil {
+		t.Fatalf("expected the budget to be replenished: %v", err)
+	}
+
+	usage := quota.Usage(DefaultConsumer)
+	if usage.Used != 1 || usage.Total != 2 || usage.Rejected != 1 {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+}
+
+func TestQuotaConsumers(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/user/spez/about": `{"kind":"t2","data":{"name":"spez"}}`}}
+	client := newTestClient(t, fake)
+
+	quota := NewQuotaManager()
+	quota.SetBudget("batch", 1, 0)
+	client.Quota(quota)
+
+	batch := client.Consumer("batch")
+	interactive := client.With(WithConsumer("interactive"))
+
+	for _, call := range []*ReadOnlyRedditClient{client, batch, interactive, interactive} {
+		if _, err := call.AboutUser("spez"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	_, err := batch.AboutUser("spez")
+	if quotaErr, ok := err.(*QuotaExceededError); !ok || !quotaErr.Reset.IsZero() {
+		t.Errorf("expected a budget never replenished to be exceeded, got %v", err)
+	}
+
+	expected := []QuotaUsage{
+		{Consumer: "batch", Budget: 1, Used: 1, Total: 1, Rejected: 1},
+		{Consumer: DefaultConsumer, Used: 1, Total: 1},
+		{Consumer: "interactive", Used: 2, Total: 2},
+	}
+	usages := quota.Usages()
+	if len(usages) != len(expected) {
+		t.Fatalf("expected %d consumers, got %+v", len(expected), usages)
+	}
+	for index := range expected {
+		if usages[index] != expected[index] {
+			t.Errorf("expected %+v, got %+v", expected[index], usages[index])
+		}
+	}
+
+	// removing the budget lifts the limit
+	quota.SetBudget("batch", 0, 0)
+	if _, err := batch.AboutUser("spez"); err != nil {
+		t.Errorf("unexpected error without a budget: %v", err)
+	}
+}
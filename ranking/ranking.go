@@ -0,0 +1,120 @@
+// Package ranking implements Reddit's classic hot, controversy and confidence formulas, so submissions merged from
+// several listings can be re-ranked consistently.
+package ranking
+
+import (
+	"math"
+	"sort"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// hotEpoch is the reference timestamp used by Reddit's hot formula
+const hotEpoch = 1134028003
+
+// confidenceZ is the z-score used by Reddit's confidence formula, corresponding to a confidence level of 80%
+const confidenceZ = 1.281551565545
+
+// Scorer computes a ranking score for a submission; higher scores rank first
+type Scorer func(submission *redditreadgo.Submission) float64
+
+// HotScore returns Reddit's hot score for the given votes and creation time, expressed in epoch seconds
+func HotScore(ups int, downs int, createdUTC float64) float64 {
+	score := float64(ups - downs)
+	order := math.Log10(math.Max(math.Abs(score), 1))
+
+	sign := 0.0
+	if score > 0 {
+		sign = 1
+	} else if score < 0 {
+		sign = -1
+	}
+
+	seconds := createdUTC - hotEpoch
+	return round(sign*order+seconds/45000, 7)
+}
+
+// ControversyScore returns Reddit's controversy score for the given votes
+func ControversyScore(ups int, downs int) float64 {
+	if ups <= 0 || downs <= 0 {
+		return 0
+	}
+
+	magnitude := float64(ups + downs)
+	balance := float64(ups) / float64(downs)
+	if ups > downs {
+		balance = float64(downs) / float64(ups)
+	}
+
+	return math.Pow(magnitude, balance)
+}
+
+// ConfidenceScore returns Reddit's confidence score, the lower bound of the Wilson score interval, for the given votes
+func ConfidenceScore(ups int, downs int) float64 {
+	n := float64(ups + downs)
+	if n <= 0 {
+		return 0
+	}
+
+	z := confidenceZ
+	phat := float64(ups) / n
+	return (phat + z*z/(2*n) - z*math.Sqrt((phat*(1-phat)+z*z/(4*n))/n)) / (1 + z*z/n)
+}
+
+// Hot scores a submission using Reddit's hot formula, scoring a nil submission 0
+func Hot(submission *redditreadgo.Submission) float64 {
+	if submission == nil {
+		return 0
+	}
+
+	ups, downs := Votes(submission)
+	return HotScore(ups, downs, submission.CreatedUTC)
+}
+
+// Controversy scores a submission using Reddit's controversy formula
+func Controversy(submission *redditreadgo.Submission) float64 {
+	ups, downs := Votes(submission)
+	return ControversyScore(ups, downs)
+}
+
+// Confidence scores a submission using Reddit's confidence formula
+func Confidence(submission *redditreadgo.Submission) float64 {
+	ups, downs := Votes(submission)
+	return ConfidenceScore(ups, downs)
+}
+
+// Votes returns the up and down votes of a submission, none for a nil submission. Reddit no longer exposes down votes,
+// so when they are missing they are estimated from the upvote ratio.
+func Votes(submission *redditreadgo.Submission) (int, int) {
+	if submission == nil {
+		return 0, 0
+	}
+
+	ups, downs := submission.Ups, submission.Downs
+	if downs == 0 && ups > 0 && submission.UpvoteRatio > 0 && submission.UpvoteRatio < 1 {
+		downs = int(math.Floor(float64(ups)*(1-submission.UpvoteRatio)/submission.UpvoteRatio + 0.5))
+	}
+	return ups, downs
+}
+
+// Rank stably sorts the submissions by descending score. Nil submissions are not scored and sort last.
+func Rank(submissions []*redditreadgo.Submission, scorer Scorer) {
+	scores := make(map[*redditreadgo.Submission]float64, len(submissions))
+	for _, submission := range submissions {
+		if submission != nil {
+			scores[submission] = scorer(submission)
+		}
+	}
+
+	sort.SliceStable(submissions, func(i, j int) bool {
+		if submissions[i] == nil || submissions[j] == nil {
+			return submissions[j] == nil && submissions[i] != nil
+		}
+		return scores[submissions[i]] > scores[submissions[j]]
+	})
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Floor(value*factor+0.5) / factor
+}
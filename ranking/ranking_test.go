@@ -0,0 +1,112 @@
+package ranking
+
+import (
+	"math"
+	"testing"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// the expected values below are computed with the _hot, controversy and _confidence functions of Reddit's
+// r2/r2/lib/db/_sorts.pyx
+
+func TestHotScore(t *testing.T) {
+
+	tests := []struct {
+		ups, downs int
+		createdUTC float64
+		expected   float64
+	}{
+		{1, 0, hotEpoch, 0},
+		{1000, 0, hotEpoch + 45000, 4},
+		{0, 10, hotEpoch, -1},
+		{5, 5, hotEpoch + 90000, 2},
+		{1, 1, hotEpoch - 45000, -1},
+		{4512, 312, 1530000000, 8803.0009604},
+		{3, 17, 1262304000, 2849.4315831},
+	}
+
+	for _, test := range tests {
+		if score := HotScore(test.ups, test.downs, test.createdUTC); math.Abs(score-test.expected) > 1e-7 {
+			t.Errorf("HotScore(%d, %d, %.0f): expected %v, got %v", test.ups, test.downs, test.createdUTC, test.expected, score)
+		}
+	}
+}
+
+func TestControversyScore(t *testing.T) {
+
+	tests := []struct {
+		ups, downs int
+		expected   float64
+	}{
+		{0, 5, 0},
+		{5, 0, 0},
+		{100, 100, 200},
+		{100, 50, 12.24744871391589},
+		{50, 100, 12.24744871391589},
+		{1, 2, 1.7320508075688772},
+		{1000, 10, 1.0716259302583164},
+	}
+
+	for _, test := range tests {
+		if score := ControversyScore(test.ups, test.downs); math.Abs(score-test.expected) > 1e-9 {
+			t.Errorf("ControversyScore(%d, %d): expected %v, got %v", test.ups, test.downs, test.expected, score)
+		}
+	}
+}
+
+func TestConfidenceScore(t *testing.T) {
+
+	tests := []struct {
+		ups, downs int
+		expected   float64
+	}{
+		{0, 0, 0},
+		{1, 0, 0.37844750322520615},
+		{0, 1, 0},
+		{10, 0, 0.8589313179093836},
+		{50, 50, 0.4364422244600835},
+		{100, 1, 0.9674801510606601},
+		{3, 7, 0.1537991780759345},
+		{1000, 250, 0.7851114159406355},
+	}
+
+	for _, test := range tests {
+		if score := ConfidenceScore(test.ups, test.downs); math.Abs(score-test.expected) > 1e-9 {
+			t.Errorf("ConfidenceScore(%d, %d): expected %v, got %v", test.ups, test.downs, test.expected, score)
+		}
+	}
+}
+
+func TestNilSubmission(t *testing.T) {
+
+	for name, scorer := range map[string]Scorer{"Hot": Hot, "Controversy": Controversy, "Confidence": Confidence} {
+		if score := scorer(nil); score != 0 {
+			t.Errorf("%s: expected 0 for a nil submission, got %v", name, score)
+		}
+	}
+
+	if ups, downs := Votes(nil); ups != 0 || downs != 0 {
+		t.Errorf("expected no votes for a nil submission, got %d and %d", ups, downs)
+	}
+}
+
+func TestRank(t *testing.T) {
+
+	low := &redditreadgo.Submission{ID: "low", Ups: 1}
+	high := &redditreadgo.Submission{ID: "high", Ups: 100}
+	tie := &redditreadgo.Submission{ID: "tie", Ups: 100}
+	submissions := []*redditreadgo.Submission{nil, low, high, nil, tie}
+
+	Rank(submissions, func(submission *redditreadgo.Submission) float64 {
+		// nil submissions must not reach the scorer
+		return float64(submission.Ups)
+	})
+
+	expected := []*redditreadgo.Submission{high, tie, low, nil, nil}
+	for index := range expected {
+		if submissions[index] != expected[index] {
+			t.Fatalf("unexpected order at index %d: %v", index, submissions)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package redditreadgo
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// RateLimitLow is emitted when Reddit reports fewer remaining requests than the RateLimitWarning threshold
+type RateLimitLow struct {
+	At        time.Time
+	URL       string
+	Remaining float64
+	// Reset is the remaining time until the rate limit window resets
+	Reset time.Duration
+}
+
+// Time returns the moment the event occurred
+func (e RateLimitLow) Time() time.Time { return e.At }
+
+// RateLimitWarning sets the no. of remaining requests below which a RateLimitLow event is published after each
+// response. With autoSlow, requests are then also spread evenly over the rest of the rate limit window, avoiding
+// HTTP 429 responses. Disable by setting threshold to 0. Disabled by default.
+func (c *ReadOnlyRedditClient) RateLimitWarning(threshold int, autoSlow bool) {
+	c.lowRateLimit = threshold
+	c.autoSlow = autoSlow
+}
+
+// warnRateLimit publishes a RateLimitLow event if Reddit reported fewer remaining requests than the threshold
+func (c *ReadOnlyRedditClient) warnRateLimit(url string, now time.Time) {
+
+	remaining, reset, low := c.rateLimits.low(c.lowRateLimit, now)
+	if !low {
+		return
+	}
+
+	if c.logger != nil {
+		c.logger.Debugf("rate limit low: %v requests remaining for %v", remaining, reset)
+	}
+	c.publish(RateLimitLow{At: now, URL: url, Remaining: remaining, Reset: reset})
+}
+
+// slowDown waits, when auto slow is enabled and the rate limit is low, for the share of the rest of the window
+// each remaining request is entitled to
+func (c *ReadOnlyRedditClient) slowDown(ctx context.Context) error {
+
+	if !c.autoSlow {
+		return nil
+	}
+
+	remaining, reset, low := c.rateLimits.low(c.lowRateLimit, time.Now())
+	if !low {
+		return nil
+	}
+
+	delay := time.Duration(float64(reset) / math.Max(remaining, 1))
+	if c.logger != nil {
+		c.logger.Debugf("rate limit low, slowing down by %v", delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// low reports the remaining requests and time of the window, and whether fewer requests than threshold remain
+func (s *rateLimitState) low(threshold int, now time.Time) (float64, time.Duration, bool) {
+
+	if s == nil || threshold <= 0 {
+		return 0, 0, false
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.known || !now.Before(s.reset) || s.remaining >= float64(threshold) {
+		return 0, 0, false
+	}
+
+	return s.remaining, s.reset.Sub(now), true
+}
@@ -0,0 +1,105 @@
+package redditreadgo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitLow(t *testing.T) {
+
+	now := time.Now()
+	reset := now.Add(time.Minute)
+
+	tests := []struct {
+		name      string
+		state     *rateLimitState
+		threshold int
+		low       bool
+	}{
+		{"nil", nil, 10, false},
+		{"disabled", &rateLimitState{known: true, remaining: 5, reset: reset}, 0, false},
+		{"unknown", &rateLimitState{}, 10, false},
+		{"window over", &rateLimitState{known: true, remaining: 5, reset: now}, 10, false},
+		{"at threshold", &rateLimitState{known: true, remaining: 10, reset: reset}, 10, false},
+		{"below threshold", &rateLimitState{known: true, remaining: 5, reset: reset}, 10, true},
+	}
+
+	for _, test := range tests {
+		remaining, wait, low := test.state.low(test.threshold, now)
+		if low != test.low {
+			t.Errorf("%s: expected low %v, got %v", test.name, test.low, low)
+			continue
+		}
+		if low && (remaining != 5 || wait != time.Minute) {
+			t.Errorf("%s: expected 5 requests remaining for 1m0s, got %v for %v", test.name, remaining, wait)
+		}
+	}
+}
+
+func TestRateLimitWarning(t *testing.T) {
+
+	remaining := "50"
+	fake := &fakeReddit{expiresIn: 3600, answer: func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ratelimit-Used", "550")
+		w.Header().Set("X-Ratelimit-Remaining", remaining)
+		w.Header().Set("X-Ratelimit-Reset", "60")
+		w.Write(listingPage(1, 0))
+	}}
+	client := newTestClient(t, fake)
+	client.RateLimitWarning(10, false)
+
+	bus := NewEventBus()
+	var warnings []RateLimitLow
+	bus.Subscribe(func(event Event) {
+		if event, ok := event.(RateLimitLow); ok {
+			warnings = append(warnings, event)
+		}
+	})
+	client.Events(bus)
+
+	for _, remaining = range []string{"50", "5"} {
+		if _, _, err := client.SubmissionsTo("golang", NewSubmissions, AllTime, ListingOptions{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected a single warning, once fewer than 10 requests remain, got %+v", warnings)
+	}
+	if warning := warnings[0]; warning.Remaining != 5 || warning.Reset <= 0 || warning.Reset > time.Minute {
+		t.Errorf("expected 5 requests remaining for up to a minute, got %+v", warning)
+	}
+}
+
+func TestSlowDown(t *testing.T) {
+
+	low := func() *rateLimitState {
+		return &rateLimitState{known: true, used: 598, remaining: 2, reset: time.Now().Add(200 * time.Millisecond)}
+	}
+
+	start := time.Now()
+	client := &ReadOnlyRedditClient{rateLimits: low(), lowRateLimit: 10, autoSlow: true}
+	if err := client.slowDown(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// each of the 2 remaining requests is entitled to half of the rest of the window
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Errorf("expected to wait for about 100ms, waited %v", elapsed)
+	}
+
+	start = time.Now()
+	warnOnly := &ReadOnlyRedditClient{rateLimits: low(), lowRateLimit: 10}
+	if err := warnOnly.slowDown(context.Background()); err != nil || time.Since(start) > 50*time.Millisecond {
+		t.Errorf("expected no wait without auto slow, got %v after %v", err, time.Since(start))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.rateLimits = low()
+	if err := client.slowDown(ctx); err != context.Canceled {
+		t.Errorf("expected the cancellation of the context, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+// Package redditreadgotest provides a conformance suite for implementations of redditreadgo.IReadOnlyRedditClient.
+//
+// Alternative backends (mocks, archive-backed clients, wrappers) can prove they behave like the real client by
+// running the suite from their own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		redditreadgotest.RunReadOnlyClientTests(t, newMyClient())
+//	}
+package redditreadgotest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// Fixture specifies the subreddit and author the conformance suite queries. Backends serving recorded data must be
+// able to answer listings and about pages for both, along with the comments of the newest submission to the subreddit.
+type Fixture struct {
+	Subreddit string
+	Author    string
+	// Total is the number of submissions requested from the AllSubmissions methods; should exceed one slice
+	Total int
+}
+
+// DefaultFixture is used by RunReadOnlyClientTests
+var DefaultFixture = Fixture{
+	Subreddit: "golang",
+	Author:    "spez",
+	Total:     redditreadgo.DefaultSliceSize + 10,
+}
+
+// RunReadOnlyClientTests runs the conformance suite against the given client using DefaultFixture
+func RunReadOnlyClientTests(t *testing.T, client redditreadgo.IReadOnlyRedditClient) {
+	RunReadOnlyClientTestsWith(t, client, DefaultFixture)
+}
+
+// RunReadOnlyClientTestsWith runs the conformance suite against the given client using the given fixture
+func RunReadOnlyClientTestsWith(t *testing.T, client redditreadgo.IReadOnlyRedditClient, fixture Fixture) {
+
+	t.Run("SubmissionsToRejectsEmptySubreddit", func(t *testing.T) {
+		if _, _, err := client.SubmissionsTo("", redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{}); err == nil {
+			t.Error("expected an error for an empty subreddit")
+		}
+	})
+
+	t.Run("SubmissionsOfRejectsEmptyAuthor", func(t *testing.T) {
+		if _, _, err := client.SubmissionsOf("", redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{}); err == nil {
+			t.Error("expected an error for an empty author")
+		}
+	})
+
+	t.Run("RejectsInvalidListingOptions", func(t *testing.T) {
+		params := redditreadgo.ListingOptions{After: "t3_a", Before: "t3_b"}
+		if _, _, err := client.SubmissionsTo(fixture.Subreddit, redditreadgo.NewSubmissions, redditreadgo.AllTime, params); err == nil {
+			t.Error("expected an error when both after and before are set")
+		}
+	})
+
+	t.Run("SubmissionsTo", func(t *testing.T) {
+		params := redditreadgo.ListingOptions{Limit: 5}
+		submissions, slice, err := client.SubmissionsTo(fixture.Subreddit, redditreadgo.NewSubmissions, redditreadgo.AllTime, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slice == nil {
+			t.Fatal("expected slice info")
+		}
+		if len(submissions) > params.Limit {
+			t.Errorf("expected at most %d submissions, got %d", params.Limit, len(submissions))
+		}
+		checkSubmissions(t, submissions)
+
+		if len(submissions) == 0 || len(slice.After) == 0 {
+			return
+		}
+
+		next, _, err := client.SubmissionsTo(fixture.Subreddit, redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{Limit: 5, After: slice.After})
+		if err != nil {
+			t.Fatalf("unexpected error fetching the next slice: %v", err)
+		}
+		for _, submission := range next {
+			for _, previous := range submissions {
+				if submission.ID == previous.ID {
+					t.Errorf("submission %s returned again in the next slice", submission.ID)
+				}
+			}
+		}
+	})
+
+	t.Run("SubmissionsOf", func(t *testing.T) {
+		submissions, slice, err := client.SubmissionsOf(fixture.Author, redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{Limit: 5})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slice == nil {
+			t.Fatal("expected slice info")
+		}
+		checkSubmissions(t, submissions)
+		for _, submission := range submissions {
+			if submission.Author != fixture.Author {
+				t.Errorf("submission %s was authored by %s, not %s", submission.ID, submission.Author, fixture.Author)
+			}
+		}
+	})
+
+	t.Run("CommentsOfRejectsEmptySubmission", func(t *testing.T) {
+		if _, err := client.CommentsOf("", redditreadgo.TopComments, redditreadgo.CommentOptions{}); err == nil {
+			t.Error("expected an error for an empty submission id")
+		}
+	})
+
+	t.Run("AboutSubredditRejectsEmptyName", func(t *testing.T) {
+		if _, err := client.AboutSubreddit(""); err == nil {
+			t.Error("expected an error for an empty subreddit")
+		}
+	})
+
+	t.Run("AboutUserRejectsEmptyName", func(t *testing.T) {
+		if _, err := client.AboutUser(""); err == nil {
+			t.Error("expected an error for an empty username")
+		}
+	})
+
+	t.Run("Submission", func(t *testing.T) {
+		id := newestSubmissionID(t, client, fixture)
+		submission, err := client.Submission(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if submission == nil || submission.ID != id {
+			t.Errorf("expected submission %s, got %v", id, submission)
+		}
+	})
+
+	t.Run("SubmissionWithComments", func(t *testing.T) {
+		id := newestSubmissionID(t, client, fixture)
+		submission, comments, err := client.SubmissionWithComments(id, redditreadgo.CommentOptions{Limit: 10})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if submission == nil || submission.ID != id {
+			t.Errorf("expected submission %s, got %v", id, submission)
+		}
+		checkComments(t, comments, id)
+	})
+
+	t.Run("CommentsOf", func(t *testing.T) {
+		id := newestSubmissionID(t, client, fixture)
+		comments, err := client.CommentsOf(id, redditreadgo.TopComments, redditreadgo.CommentOptions{Limit: 10})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkComments(t, comments, id)
+	})
+
+	t.Run("CommentsBy", func(t *testing.T) {
+		comments, slice, err := client.CommentsBy(fixture.Author, redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{Limit: 5})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if slice == nil {
+			t.Fatal("expected slice info")
+		}
+		for index, comment := range comments {
+			if comment == nil {
+				t.Errorf("comment at index %d is nil", index)
+				continue
+			}
+			if !comment.IsMore() && comment.Author != fixture.Author {
+				t.Errorf("comment %s was authored by %s, not %s", comment.ID, comment.Author, fixture.Author)
+			}
+		}
+	})
+
+	t.Run("AboutSubreddit", func(t *testing.T) {
+		subreddit, err := client.AboutSubreddit(fixture.Subreddit)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.EqualFold(subreddit.DisplayName, fixture.Subreddit) {
+			t.Errorf("expected subreddit %s, got %s", fixture.Subreddit, subreddit.DisplayName)
+		}
+	})
+
+	t.Run("RulesOf", func(t *testing.T) {
+		rules, err := client.RulesOf(fixture.Subreddit)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for index, rule := range rules {
+			if rule == nil || len(rule.ShortName) == 0 {
+				t.Errorf("rule at index %d is nil or has no name", index)
+			}
+		}
+	})
+
+	t.Run("AboutUser", func(t *testing.T) {
+		account, err := client.AboutUser(fixture.Author)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.EqualFold(account.Name, fixture.Author) {
+			t.Errorf("expected account %s, got %s", fixture.Author, account.Name)
+		}
+	})
+
+	t.Run("TrophiesOf", func(t *testing.T) {
+		trophies, err := client.TrophiesOf(fixture.Author)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for index, trophy := range trophies {
+			if trophy == nil || len(trophy.Name) == 0 {
+				t.Errorf("trophy at index %d is nil or has no name", index)
+			}
+		}
+	})
+
+	t.Run("AllSubmissionsTo", func(t *testing.T) {
+		submissions, err := client.AllSubmissionsTo(fixture.Subreddit, redditreadgo.NewSubmissions, redditreadgo.AllTime, fixture.Total)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkTotal(t, submissions, fixture.Total)
+	})
+
+	t.Run("AllSubmissionsOf", func(t *testing.T) {
+		submissions, err := client.AllSubmissionsOf(fixture.Author, redditreadgo.NewSubmissions, redditreadgo.AllTime, fixture.Total)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkTotal(t, submissions, fixture.Total)
+	})
+}
+
+// newestSubmissionID returns the id of the newest submission to the subreddit of the fixture, skipping the test if
+// there is none
+func newestSubmissionID(t *testing.T, client redditreadgo.IReadOnlyRedditClient, fixture Fixture) string {
+	submissions, _, err := client.SubmissionsTo(fixture.Subreddit, redditreadgo.NewSubmissions, redditreadgo.AllTime, redditreadgo.ListingOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error listing the subreddit: %v", err)
+	}
+	if len(submissions) == 0 || submissions[0] == nil {
+		t.Skipf("no submission to %s", fixture.Subreddit)
+	}
+	return submissions[0].ID
+}
+
+func checkComments(t *testing.T, comments []*redditreadgo.Comment, submissionID string) {
+	for index, comment := range comments {
+		if comment == nil {
+			t.Errorf("comment at index %d is nil", index)
+			continue
+		}
+		if comment.IsMore() {
+			continue
+		}
+		if len(comment.ID) == 0 {
+			t.Errorf("comment at index %d has no id", index)
+		}
+		if comment.LinkID != "t3_"+submissionID {
+			t.Errorf("comment %s belongs to %s, not to t3_%s", comment.ID, comment.LinkID, submissionID)
+		}
+		checkComments(t, comment.Replies, submissionID)
+	}
+}
+
+func checkSubmissions(t *testing.T, submissions []*redditreadgo.Submission) {
+	for index, submission := range submissions {
+		if submission == nil {
+			t.Errorf("submission at index %d is nil", index)
+			continue
+		}
+		if len(submission.ID) == 0 || len(submission.Name) == 0 {
+			t.Errorf("submission at index %d has no id or name", index)
+		}
+	}
+}
+
+func checkTotal(t *testing.T, submissions []*redditreadgo.Submission, total int) {
+	if len(submissions) > total {
+		t.Errorf("expected at most %d submissions, got %d", total, len(submissions))
+	}
+	checkSubmissions(t, submissions)
+
+	seen := make(map[string]bool)
+	for _, submission := range submissions {
+		if submission != nil && seen[submission.ID] {
+			t.Errorf("submission %s returned more than once", submission.ID)
+		}
+		if submission != nil {
+			seen[submission.ID] = true
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package redditreadgotest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+	"github.com/MihaiBogdanEugen/redditreadgo/redditreadgotest"
+)
+
+// fakeReddit serves a subreddit of generated submissions, all made by the author of the fixture, each with a few
+// comments, along with the about pages of both
+type fakeReddit struct {
+	fixture     redditreadgotest.Fixture
+	submissions int
+	// requests counts the requests answered, tokens included
+	requests int64
+}
+
+func (f *fakeReddit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	atomic.AddInt64(&f.requests, 1)
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+	subreddit := "/r/" + f.fixture.Subreddit
+	user := "/user/" + f.fixture.Author
+
+	var document interface{}
+	switch path := r.URL.Path; {
+	case path == "/api/v1/access_token":
+		document = map[string]interface{}{"access_token": "token", "token_type": "bearer", "expires_in": 3600, "scope": "*"}
+	case path == subreddit+"/new" || path == user+"/submitted":
+		document = f.submissionListing(r.URL.Query())
+	case strings.HasPrefix(path, "/comments/"):
+		id := strings.TrimPrefix(path, "/comments/")
+		document = []interface{}{listing([]interface{}{f.submission(id)}, ""), listing(f.comments(id), "")}
+	case path == "/api/info":
+		var children []interface{}
+		for _, name := range strings.Split(r.URL.Query().Get("id"), ",") {
+			children = append(children, f.submission(strings.TrimPrefix(name, "t3_")))
+		}
+		document = listing(children, "")
+	case path == user+"/comments":
+		document = listing(f.comments("s0"), "")
+	case path == subreddit+"/about":
+		document = thing("t5", map[string]interface{}{"display_name": f.fixture.Subreddit, "subscribers": 1000})
+	case path == subreddit+"/about/rules":
+		document = map[string]interface{}{"rules": []interface{}{map[string]interface{}{"kind": "all", "short_name": "Be nice", "priority": 0}}}
+	case path == user+"/about":
+		document = thing("t2", map[string]interface{}{"name": f.fixture.Author, "link_karma": 10})
+	case path == "/api/v1/user/"+f.fixture.Author+"/trophies":
+		document = thing("TrophyList", map[string]interface{}{"trophies": []interface{}{thing("t6", map[string]interface{}{"name": "Verified Email"})}})
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	json.NewEncoder(w).Encode(document)
+}
+
+// submissionListing returns the slice of submissions following the after cursor of the query
+func (f *fakeReddit) submissionListing(query url.Values) interface{} {
+
+	start := 0
+	if after := query.Get("after"); len(after) > 0 {
+		index, err := strconv.Atoi(strings.TrimPrefix(after, "t3_s"))
+		if err != nil {
+			return listing(nil, "")
+		}
+		start = index + 1
+	}
+
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if limit <= 0 {
+		limit = 25
+	}
+
+	var children []interface{}
+	for index := start; index < f.submissions && len(children) < limit; index++ {
+		children = append(children, f.submission(fmt.Sprintf("s%d", index)))
+	}
+
+	after := ""
+	if start+len(children) < f.submissions {
+		after = fmt.Sprintf("t3_s%d", start+len(children)-1)
+	}
+
+	return listing(children, after)
+}
+
+func (f *fakeReddit) submission(id string) interface{} {
+	return thing("t3", map[string]interface{}{
+		"id": id, "name": "t3_" + id, "title": "submission " + id, "author": f.fixture.Author, "subreddit": f.fixture.Subreddit,
+	})
+}
+
+func (f *fakeReddit) comments(id string) []interface{} {
+
+	comments := make([]interface{}, 3)
+	for index := range comments {
+		commentID := fmt.Sprintf("%s_c%d", id, index)
+		comments[index] = thing("t1", map[string]interface{}{
+			"id": commentID, "name": "t1_" + commentID, "link_id": "t3_" + id, "author": f.fixture.Author, "body": "comment", "replies": "",
+		})
+	}
+
+	return comments
+}
+
+func thing(kind string, data interface{}) interface{} {
+	return map[string]interface{}{"kind": kind, "data": data}
+}
+
+func listing(children []interface{}, after string) interface{} {
+	if children == nil {
+		children = []interface{}{}
+	}
+	return thing("Listing", map[string]interface{}{"children": children, "after": after, "dist": len(children)})
+}
+
+// redirectTransport sends every request to the given server, whatever host it was meant for
+type redirectTransport struct {
+	server    *url.URL
+	transport http.RoundTripper
+}
+
+func (t *redirectTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	redirected := new(http.Request)
+	*redirected = *request
+	location := *request.URL
+	location.Scheme, location.Host = t.server.Scheme, t.server.Host
+	redirected.URL = &location
+
+	return t.transport.RoundTrip(redirected)
+}
+
+func TestConformance(t *testing.T) {
+
+	fake := &fakeReddit{fixture: redditreadgotest.DefaultFixture, submissions: redditreadgotest.DefaultFixture.Total + 40}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	// the client is meant to reach Reddit, route its requests to the fake instead
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: &redirectTransport{server: serverURL, transport: server.Client().Transport}}
+
+	dir, err := ioutil.TempDir("", "redditreadgotest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	t.Run("ReadOnlyRedditClient", func(t *testing.T) {
+		client, err := redditreadgo.NewClientWithHTTPClient("id", "secret", "redditreadgotest/1.0", httpClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		archive, err := redditreadgo.NewDirArchive(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.WithRawArchive(archive)
+
+		redditreadgotest.RunReadOnlyClientTests(t, client)
+	})
+
+	t.Run("ArchiveReplayClient", func(t *testing.T) {
+		client, err := redditreadgo.NewArchiveReplayClient(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		requests := atomic.LoadInt64(&fake.requests)
+		redditreadgotest.RunReadOnlyClientTests(t, client)
+		if replayed := atomic.LoadInt64(&fake.requests); replayed != requests {
+			t.Errorf("expected every page to be replayed from the archive, %d requests reached the server", replayed-requests)
+		}
+	})
+}
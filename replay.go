@@ -0,0 +1,97 @@
+package redditreadgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ArchiveReplayClient serves pages previously stored by a DirArchive instead of querying Reddit, so analyses can be
+// reproduced without new API calls. Every method of IReadOnlyRedditClient is available; a call succeeds when the
+// exact page it requests, cursors included, was archived, and fails otherwise with a *url.Error wrapping a
+// NotArchivedError.
+type ArchiveReplayClient struct {
+	*ReadOnlyRedditClient
+}
+
+// NewArchiveReplayClient creates a client replaying the pages archived in the given directory
+func NewArchiveReplayClient(dir string) (*ArchiveReplayClient, error) {
+
+	if len(dir) == 0 {
+		return nil, errors.New("dir cannot be null nor empty")
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	client := &ReadOnlyRedditClient{
+		userAgent:  "redditreadgo-replay",
+		httpClient: &http.Client{Transport: &replayTransport{archive: &DirArchive{dir: dir}}},
+		sliceSizes: &sliceSizes{},
+		authors:    &authorCache{},
+		grantType:  ReplayGrant,
+		// replayed pages need no authorization, the token never expires
+		tokens: &tokenStore{token: &oauth2.Token{AccessToken: "replay", TokenType: "bearer", Expiry: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	client.Token = client.tokens.token
+
+	return &ArchiveReplayClient{ReadOnlyRedditClient: client}, nil
+}
+
+// replayTransport answers GET requests with archived pages
+type replayTransport struct {
+	archive *DirArchive
+}
+
+func (t *replayTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+
+	if request.Body != nil {
+		request.Body.Close()
+	}
+
+	if request.Method != "GET" {
+		return nil, fmt.Errorf("cannot replay %s requests", request.Method)
+	}
+
+	page, err := t.archive.Load(request.URL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json; charset=UTF-8")
+	header.Set("Content-Length", strconv.Itoa(len(page.Body)))
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(page.Body)),
+		ContentLength: int64(len(page.Body)),
+		Request:       request,
+	}, nil
+}
+
+// Gateway fails for any options but the empty ones, pages being replayed from the archive under Reddit's URLs
+func (c *ArchiveReplayClient) Gateway(opts GatewayOptions) error {
+	if opts != (GatewayOptions{}) {
+		return errors.New("archived pages cannot be replayed through a gateway")
+	}
+	return nil
+}
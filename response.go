@@ -0,0 +1,114 @@
+package redditreadgo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// UnexpectedContentTypeError is returned when a response is not JSON, e.g. an HTML maintenance page served by a CDN
+type UnexpectedContentTypeError struct {
+	URL string
+	// ContentType is the raw Content-Type header of the response
+	ContentType string
+}
+
+func (e *UnexpectedContentTypeError) Error() string {
+	return fmt.Sprintf("unknown response content type: %q", e.ContentType)
+}
+
+// checkJSONContentType accepts application/json, text/json and any +json media type, regardless of parameters
+// such as charset or letter case
+func checkJSONContentType(url string, header string) error {
+
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		// tolerate malformed parameters, e.g. "application/json; charset"
+		mediaType = strings.ToLower(strings.TrimSpace(strings.Split(header, ";")[0]))
+	}
+
+	switch {
+	case mediaType == "application/json", mediaType == "text/json", mediaType == "application/x-json":
+		return nil
+	case strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json"):
+		return nil
+	}
+
+	return &UnexpectedContentTypeError{URL: url, ContentType: header}
+}
+
+// decodedBody returns the response body, decompressed according to its Content-Encoding
+func decodedBody(response *http.Response) (io.ReadCloser, error) {
+
+	switch encoding := strings.ToLower(strings.TrimSpace(response.Header.Get("Content-Encoding"))); encoding {
+	case "gzip", "x-gzip":
+		return gzip.NewReader(response.Body)
+	case "", "identity":
+		return ioutil.NopCloser(response.Body), nil
+	default:
+		return nil, fmt.Errorf("unsupported response content encoding: %s", encoding)
+	}
+}
+
+// MaxResponseBytes sets the hard cap on the decompressed size of a response body, protecting against compression
+// bombs and runaway pages. A limit of 0 or less restores MaxResponseSize.
+func (c *ReadOnlyRedditClient) MaxResponseBytes(limit int) {
+	c.maxResponseSize = limit
+}
+
+func (c *ReadOnlyRedditClient) responseLimit() int {
+	if c.maxResponseSize > 0 {
+		return c.maxResponseSize
+	}
+	return MaxResponseSize
+}
+
+// readLimited reads the whole body into the given buffer, failing with ResponseTooLargeError beyond limit bytes, and
+// returns it along with its hex encoded SHA-256 digest computed while streaming. The body is only valid until the
+// buffer is reused.
+func readLimited(url string, reader io.Reader, limit int, buffer *bytes.Buffer) ([]byte, string, error) {
+
+	hash := sha256.New()
+	_, err := buffer.ReadFrom(io.TeeReader(io.LimitReader(reader, int64(limit)+1), hash))
+	body := buffer.Bytes()
+	if err == io.ErrUnexpectedEOF {
+		return body, "", err
+	}
+	if err != nil {
+		return body, "", fmt.Errorf("cannot read body of response: %v", err)
+	}
+
+	if len(body) > limit {
+		return body, "", &ResponseTooLargeError{URL: url, Limit: limit}
+	}
+
+	return body, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// maxPooledBufferSize specifies the capacity past which read buffers are left to the garbage collector
+const maxPooledBufferSize = 4 * MaxResponseSize
+
+// bufferPool keeps the buffers response bodies are read into, sparing the allocations of growing a new one per page
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+func getBuffer() *bytes.Buffer {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	return buffer
+}
+
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() <= maxPooledBufferSize {
+		bufferPool.Put(buffer)
+	}
+}
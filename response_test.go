@@ -0,0 +1,218 @@
+package redditreadgo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckJSONContentType(t *testing.T) {
+
+	tests := []struct {
+		header   string
+		accepted bool
+	}{
+		{"application/json", true},
+		{"application/json; charset=UTF-8", true},
+		{"application/json;charset=utf-8", true},
+		{"APPLICATION/JSON; Charset=UTF-8", true},
+		{"Application/Json", true},
+		{"application/json; charset=UTF-8; boundary=x", true},
+		{" application/json ", true},
+		{"text/json", true},
+		{"application/x-json", true},
+		{"application/problem+json", true},
+		{"application/vnd.reddit+json; charset=utf-8", true},
+		// malformed parameters are tolerated as long as the media type is JSON
+		{"application/json; charset", true},
+		{"application/json; =utf-8", true},
+		{"text/html", false},
+		{"text/html; charset=UTF-8", false},
+		{"text/plain", false},
+		{"application/xml", false},
+		{"text/+json", false},
+		{"application/jsonp", false},
+		{"", false},
+		{"application json", false},
+		{";", false},
+		{"/", false},
+	}
+
+	for _, test := range tests {
+		err := checkJSONContentType("https://oauth.reddit.com/r/golang/new", test.header)
+		if test.accepted && err != nil {
+			t.Errorf("expected %q to be accepted, got %v", test.header, err)
+		}
+		if !test.accepted {
+			if _, ok := err.(*UnexpectedContentTypeError); !ok {
+				t.Errorf("expected %q to be rejected with an UnexpectedContentTypeError, got %v", test.header, err)
+			}
+		}
+	}
+}
+
+func TestUnexpectedContentTypeError(t *testing.T) {
+
+	err := checkJSONContentType("https://oauth.reddit.com/r/golang/new", `text/html; charset="utf-8"`)
+	typed, ok := err.(*UnexpectedContentTypeError)
+	if !ok {
+		t.Fatalf("expected an UnexpectedContentTypeError, got %v", err)
+	}
+
+	if typed.URL != "https://oauth.reddit.com/r/golang/new" || typed.ContentType != `text/html; charset="utf-8"` {
+		t.Errorf("unexpected error fields: %+v", typed)
+	}
+	if expected := `unknown response content type: "text/html; charset=\"utf-8\""`; err.Error() != expected {
+		t.Errorf("expected %s, got %s", expected, err.Error())
+	}
+}
+
+func TestDecodedBody(t *testing.T) {
+
+	const body = `{"kind":"Listing"}`
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	writer.Write([]byte(body))
+	writer.Close()
+
+	tests := []struct {
+		encoding string
+		body     []byte
+		err      string
+	}{
+		{"", []byte(body), ""},
+		{"identity", []byte(body), ""},
+		{"Identity", []byte(body), ""},
+		{"gzip", compressed.Bytes(), ""},
+		{"GZIP", compressed.Bytes(), ""},
+		{" x-gzip ", compressed.Bytes(), ""},
+		{"gzip", []byte(body), "gzip: invalid header"},
+		{"br", []byte(body), "unsupported response content encoding: br"},
+		{"deflate", []byte(body), "unsupported response content encoding: deflate"},
+	}
+
+	for _, test := range tests {
+		response := &http.Response{Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(test.body))}
+		if len(test.encoding) > 0 {
+			response.Header.Set("Content-Encoding", test.encoding)
+		}
+
+		reader, err := decodedBody(response)
+		if len(test.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("encoding %q: expected an error containing %q, got %v", test.encoding, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("encoding %q: unexpected error: %v", test.encoding, err)
+			continue
+		}
+
+		decoded, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err != nil || string(decoded) != body {
+			t.Errorf("encoding %q: expected %s, got %s (%v)", test.encoding, body, decoded, err)
+		}
+	}
+}
+
+func TestDoGetRequestResponseHeaders(t *testing.T) {
+
+	const page = `{"kind":"t2","data":{"name":"spez"}}`
+
+	tests := []struct {
+		name        string
+		contentType string
+		gzip        bool
+		html        bool
+	}{
+		{"identity json", "application/json; charset=UTF-8", false, false},
+		{"gzip json", "application/json; charset=UTF-8", true, false},
+		{"uppercase json", "APPLICATION/JSON", false, false},
+		{"html error page", "text/html; charset=UTF-8", false, true},
+		{"gzip html error page", "text/html", true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fake := &fakeReddit{expiresIn: 3600, contentType: test.contentType, gzip: test.gzip, pages: map[string]string{"/user/spez/about": page}}
+			client := newTestClient(t, fake)
+
+			account, err := client.AboutUser("spez")
+			if test.html {
+				if _, ok := err.(*UnexpectedContentTypeError); !ok {
+					t.Errorf("expected an UnexpectedContentTypeError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if account.Name != "spez" {
+				t.Errorf("expected spez, got %q", account.Name)
+			}
+		})
+	}
+}
+
+// listingPage returns a listing of the given no. of submissions, each with a selftext of the given length
+func listingPage(submissions int, selftext int) []byte {
+
+	children := make([]string, submissions)
+	for index := range children {
+		children[index] = fmt.Sprintf(`{"kind":"t3","data":{"id":"s%d","name":"t3_s%d","title":"submission %d","author":"spez",`+
+			`"subreddit":"golang","score":%d,"num_comments":%d,"created_utc":1530000000,"selftext":"%s"}}`,
+			index, index, index, index*3, index*2, strings.Repeat("x", selftext))
+	}
+
+	return []byte(fmt.Sprintf(`{"kind":"Listing","data":{"after":"t3_s%d","children":[%s]}}`, submissions-1, strings.Join(children, ",")))
+}
+
+func benchmarkDecode(b *testing.B, page []byte, pooled bool) {
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(page)))
+
+	for i := 0; i < b.N; i++ {
+		buffer := new(bytes.Buffer)
+		if pooled {
+			buffer = getBuffer()
+		}
+
+		body, _, err := readLimited("https://oauth.reddit.com/r/golang/new", bytes.NewReader(page), MaxResponseSize, buffer)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		var response listing
+		if err := json.Unmarshal(body, &response); err != nil {
+			b.Fatal(err)
+		}
+
+		if pooled {
+			putBuffer(buffer)
+		}
+	}
+}
+
+func BenchmarkDecodeSmallListing(b *testing.B) {
+	benchmarkDecode(b, listingPage(5, 200), false)
+}
+
+func BenchmarkDecodeSmallListingPooled(b *testing.B) {
+	benchmarkDecode(b, listingPage(5, 200), true)
+}
+
+func BenchmarkDecodeLargeListing(b *testing.B) {
+	benchmarkDecode(b, listingPage(100, 4000), false)
+}
+
+func BenchmarkDecodeLargeListingPooled(b *testing.B) {
+	benchmarkDecode(b, listingPage(100, 4000), true)
+}
@@ -0,0 +1,114 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MaxSearchQueryLength specifies the longest search query Reddit accepts
+const MaxSearchQueryLength = 512
+
+// Search returns the submissions matching the given query, site-wide or within SearchOptions.Subreddit. Only
+// submissions are searched, i.e. type is always link.
+func (c *ReadOnlyRedditClient) Search(query string, opts SearchOptions) ([]*Submission, *SliceInfo, error) {
+
+	if len(query) == 0 {
+		return nil, nil, errors.New("query cannot be null nor empty")
+	}
+
+	if len(query) > MaxSearchQueryLength {
+		return nil, nil, fmt.Errorf("query cannot exceed %d characters", MaxSearchQueryLength)
+	}
+
+	searchParams, err := encodeValues(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(opts.Listing)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for name := range searchParams {
+		queryParams.Set(name, searchParams.Get(name))
+	}
+	queryParams.Set("q", query)
+	queryParams.Set("type", "link")
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	endpoint := fmt.Sprintf("%s/search", QueryURL)
+	if len(opts.Subreddit) > 0 {
+		endpoint = fmt.Sprintf("%s/r/%s/search", QueryURL, opts.Subreddit)
+		queryParams.Set("restrict_sr", "true")
+	}
+
+	return c.searchSubmissions(endpoint, queryParams)
+}
+
+// SearchByFlair returns the submissions to the given subreddit whose link flair matches the given text, considering
+// listing options
+func (c *ReadOnlyRedditClient) SearchByFlair(subreddit string, flairText string, params ListingOptions) ([]*Submission, *SliceInfo, error) {
+
+	return c.SubmissionsWithFlair(subreddit, flairText, "", params)
+}
+
+// SubmissionsWithFlair returns the submissions to the given subreddit whose link flair matches the given
+// text, considering age sort and listing options. See LinkFlairsOf for the flairs a subreddit offers.
+func (c *ReadOnlyRedditClient) SubmissionsWithFlair(subreddit string, flairText string, age AgeSort, params ListingOptions) ([]*Submission, *SliceInfo, error) {
+
+	if err := validateSubreddit(subreddit); err != nil {
+		return nil, nil, err
+	}
+
+	if len(flairText) == 0 {
+		return nil, nil, errors.New("flairText cannot be null nor empty")
+	}
+
+	return c.Search(NewSearchQuery().Flair(flairText).String(), SearchOptions{Subreddit: subreddit, Age: age, Listing: params})
+}
+
+// searchSubmissions queries the given search endpoint, keeping the submissions of the results
+func (c *ReadOnlyRedditClient) searchSubmissions(endpoint string, queryParams url.Values) ([]*Submission, *SliceInfo, error) {
+
+	queryURL := fmt.Sprintf("%s?%v", endpoint, queryParams.Encode())
+
+	var response listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	submissions, err := decodeSubmissions(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submissions, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// decodeSubmissions decodes submissions, skipping any other kind
+func decodeSubmissions(children []thing) ([]*Submission, error) {
+
+	submissions := make([]*Submission, 0, len(children))
+	for _, child := range children {
+		if child.Kind != SubmissionKind {
+			continue
+		}
+		submission := new(Submission)
+		if err := json.Unmarshal(child.Data, submission); err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, nil
+}
+
+// quoteSearchTerm quotes the given text as a single search term, escaping backslashes and double quotes
+func quoteSearchTerm(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
@@ -0,0 +1,144 @@
+package redditreadgo
+
+import (
+	"regexp"
+	"strings"
+)
+
+var bareSearchValuePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// SearchQuery builds search queries in Reddit's Lucene syntax, quoting and escaping values as needed, e.g.
+//
+//	NewSearchQuery().Subreddit("golang").Title("generics").Not(NewSearchQuery().Self(true)).String()
+//
+// Terms are combined with AND; AnyOf combines queries with OR. A SearchQuery is not safe for concurrent use.
+type SearchQuery struct {
+	terms []string
+}
+
+// NewSearchQuery creates an empty search query
+func NewSearchQuery() *SearchQuery {
+	return &SearchQuery{}
+}
+
+// AnyOf returns a query matching any of the given ones
+func AnyOf(queries ...*SearchQuery) *SearchQuery {
+	return combine(" OR ", queries)
+}
+
+// AllOf returns a query matching all of the given ones
+func AllOf(queries ...*SearchQuery) *SearchQuery {
+	return combine(" AND ", queries)
+}
+
+// Text adds free text, matched as Reddit sees fit; operators within it are not escaped
+func (q *SearchQuery) Text(text string) *SearchQuery {
+	return q.add(strings.TrimSpace(text))
+}
+
+// Phrase adds an exact phrase
+func (q *SearchQuery) Phrase(phrase string) *SearchQuery {
+	if len(phrase) == 0 {
+		return q
+	}
+	return q.add(quoteSearchTerm(phrase))
+}
+
+// Author restricts the results to the submissions of the given author
+func (q *SearchQuery) Author(author string) *SearchQuery {
+	return q.field("author", author)
+}
+
+// Subreddit restricts the results to the submissions to the given subreddit
+func (q *SearchQuery) Subreddit(subreddit string) *SearchQuery {
+	return q.field("subreddit", subreddit)
+}
+
+// Flair restricts the results to the submissions with the given link flair
+func (q *SearchQuery) Flair(flair string) *SearchQuery {
+	return q.field("flair", flair)
+}
+
+// Self restricts the results to self posts, or to link posts if self is false
+func (q *SearchQuery) Self(self bool) *SearchQuery {
+	if self {
+		return q.add("self:yes")
+	}
+	return q.add("self:no")
+}
+
+// Site restricts the results to the submissions linking to the given domain
+func (q *SearchQuery) Site(domain string) *SearchQuery {
+	return q.field("site", domain)
+}
+
+// Title restricts the results to the submissions whose title contains the given text
+func (q *SearchQuery) Title(text string) *SearchQuery {
+	return q.field("title", text)
+}
+
+// Not excludes the results matching the given query
+func (q *SearchQuery) Not(query *SearchQuery) *SearchQuery {
+	if query == nil || len(query.terms) == 0 {
+		return q
+	}
+	return q.add("NOT " + query.group())
+}
+
+// Or adds a term matching any of the given queries
+func (q *SearchQuery) Or(queries ...*SearchQuery) *SearchQuery {
+	return q.add(AnyOf(queries...).String())
+}
+
+// String returns the query in Reddit's search syntax, e.g. to pass to Search
+func (q *SearchQuery) String() string {
+	if q == nil {
+		return ""
+	}
+	return strings.Join(q.terms, " AND ")
+}
+
+func (q *SearchQuery) field(name string, value string) *SearchQuery {
+	if len(value) == 0 {
+		return q
+	}
+	if !bareSearchValuePattern.MatchString(value) {
+		value = quoteSearchTerm(value)
+	}
+	return q.add(name + ":" + value)
+}
+
+func (q *SearchQuery) add(term string) *SearchQuery {
+	if len(term) > 0 {
+		q.terms = append(q.terms, term)
+	}
+	return q
+}
+
+// group returns the query, parenthesized if made of several terms
+func (q *SearchQuery) group() string {
+	if len(q.terms) == 1 {
+		return q.terms[0]
+	}
+	return "(" + q.String() + ")"
+}
+
+func combine(operator string, queries []*SearchQuery) *SearchQuery {
+
+	groups := make([]string, 0, len(queries))
+	for _, query := range queries {
+		if query != nil && len(query.terms) > 0 {
+			groups = append(groups, query.group())
+		}
+	}
+
+	combined := NewSearchQuery()
+	switch len(groups) {
+	case 0:
+	case 1:
+		combined.add(groups[0])
+	default:
+		combined.add("(" + strings.Join(groups, operator) + ")")
+	}
+	return combined
+}
@@ -0,0 +1,50 @@
+package redditreadgo
+
+import "testing"
+
+func TestSearchQuery(t *testing.T) {
+
+	q := NewSearchQuery
+
+	tests := []struct {
+		name     string
+		query    *SearchQuery
+		expected string
+	}{
+		{"empty", q(), ""},
+		{"nil", nil, ""},
+		{"text", q().Text("  go generics "), "go generics"},
+		{"phrase", q().Phrase("type parameters"), `"type parameters"`},
+		{"empty phrase", q().Phrase(""), ""},
+		{"quotes", q().Phrase(`say "hi"`), `"say \"hi\""`},
+		{"backslashes", q().Title(`C:\go`), `title:"C:\\go"`},
+		{"quotes and backslashes", q().Title(`\"`), `title:"\\\""`},
+		{"bare subreddit", q().Subreddit("golang"), "subreddit:golang"},
+		{"empty subreddit", q().Subreddit(""), ""},
+		{"author", q().Author("spez"), "author:spez"},
+		{"flair", q().Flair("Help wanted"), `flair:"Help wanted"`},
+		{"self", q().Self(true), "self:yes"},
+		{"link", q().Self(false), "self:no"},
+		{"site", q().Site("go.dev"), "site:go.dev"},
+		{"site with path", q().Site("go.dev/blog"), `site:"go.dev/blog"`},
+		{"title", q().Title("generics"), "title:generics"},
+		{"terms", q().Subreddit("golang").Title("generics").Self(true), "subreddit:golang AND title:generics AND self:yes"},
+		{"not", q().Subreddit("golang").Not(q().Self(true)), "subreddit:golang AND NOT self:yes"},
+		{"not group", q().Not(q().Author("a").Author("b")), "NOT (author:a AND author:b)"},
+		{"not empty", q().Subreddit("golang").Not(q()).Not(nil), "subreddit:golang"},
+		{"any of", AnyOf(q().Subreddit("golang"), q().Subreddit("rust")), "(subreddit:golang OR subreddit:rust)"},
+		{"any of groups", AnyOf(q().Subreddit("golang").Self(true), q().Site("go.dev")), "((subreddit:golang AND self:yes) OR site:go.dev)"},
+		{"any of one", AnyOf(nil, q(), q().Author("spez")), "author:spez"},
+		{"any of none", AnyOf(), ""},
+		{"all of", AllOf(q().Title("go"), AnyOf(q().Site("go.dev"), q().Site("golang.org"))), "(title:go AND (site:go.dev OR site:golang.org))"},
+		{"all of empty", AllOf(nil, q()), ""},
+		{"or", q().Subreddit("golang").Or(q().Title("generics"), q().Title("iterators")), "subreddit:golang AND (title:generics OR title:iterators)"},
+		{"or none", q().Subreddit("golang").Or(), "subreddit:golang"},
+	}
+
+	for _, test := range tests {
+		if query := test.query.String(); query != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, query)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package redditreadgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ResponseTooLargeError is returned when a response body exceeds the maximum accepted size
+type ResponseTooLargeError struct {
+	URL   string
+	Limit int
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response of %s exceeds %d bytes", e.URL, e.Limit)
+}
+
+// SliceSizeAdapted is emitted when the client shrinks the slice size used for a target after a failed slice
+type SliceSizeAdapted struct {
+	At     time.Time
+	Target string
+	From   int
+	To     int
+	Err    error
+}
+
+// Time returns the moment the event occurred
+func (e SliceSizeAdapted) Time() time.Time { return e.At }
+
+// AdaptedSliceSizes returns the slice sizes the client fell back to, keyed by target (r/{subreddit} or u/{author})
+func (c *ReadOnlyRedditClient) AdaptedSliceSizes() map[string]int {
+	return c.sliceSizes.snapshot()
+}
+
+func (c *ReadOnlyRedditClient) adaptSliceSize(target string, from int, cause error) {
+
+	to := from / 2
+	if to < MinSliceSize {
+		to = MinSliceSize
+	}
+
+	c.sliceSizes.set(target, to)
+
+	if c.logger != nil {
+		c.logger.Debugf("slice of %d failed for %s (%v), falling back to %d", from, target, cause, to)
+	}
+
+	c.publish(SliceSizeAdapted{At: time.Now(), Target: target, From: from, To: to, Err: cause})
+}
+
+// isDecodeError reports whether the error is caused by a response which was truncated or too large, which a smaller
+// slice may avoid. Responses decoding into unexpected types are not: the schema drifted, whatever the slice size.
+func isDecodeError(err error) bool {
+	switch err.(type) {
+	case *json.SyntaxError, *ResponseTooLargeError:
+		return true
+	}
+	return err == io.ErrUnexpectedEOF
+}
+
+// sliceSizes keeps the slice size adapted per target. A nil value always reports DefaultSliceSize.
+type sliceSizes struct {
+	mutex sync.Mutex
+	sizes map[string]int
+}
+
+func (s *sliceSizes) get(target string) int {
+	if s == nil {
+		return DefaultSliceSize
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if size, ok := s.sizes[target]; ok {
+		return size
+	}
+	return DefaultSliceSize
+}
+
+func (s *sliceSizes) set(target string, size int) {
+	if s == nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.sizes == nil {
+		s.sizes = make(map[string]int)
+	}
+	s.sizes[target] = size
+}
+
+func (s *sliceSizes) snapshot() map[string]int {
+	result := make(map[string]int)
+	if s == nil {
+		return result
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for target, size := range s.sizes {
+		result[target] = size
+	}
+	return result
+}
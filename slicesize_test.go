@@ -0,0 +1,106 @@
+package redditreadgo
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// slicePage answers listing requests with a page of as many submissions as requested
+func slicePage(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(listingPage(limit, 0))
+}
+
+func TestAdaptSliceSizeToLargeResponses(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, answer: slicePage}
+	client := newTestClient(t, fake)
+	client.MaxResponseBytes(len(listingPage(30, 0)))
+
+	bus := NewEventBus()
+	var adapted []SliceSizeAdapted
+	bus.Subscribe(func(event Event) {
+		if event, ok := event.(SliceSizeAdapted); ok {
+			adapted = append(adapted, event)
+		}
+	})
+	client.Events(bus)
+
+	submissions, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, DefaultSliceSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(submissions) != DefaultSliceSize {
+		t.Errorf("expected %d submissions, got %d", DefaultSliceSize, len(submissions))
+	}
+
+	if len(adapted) != 2 || adapted[0].From != 100 || adapted[0].To != 50 || adapted[1].To != 25 {
+		t.Errorf("expected the slice size to fall back from 100 to 50 to 25, got %+v", adapted)
+	}
+	if _, ok := adapted[0].Err.(*ResponseTooLargeError); !ok {
+		t.Errorf("expected the fall back to be caused by a response too large, got %v", adapted[0].Err)
+	}
+
+	sizes := client.AdaptedSliceSizes()
+	if len(sizes) != 1 || sizes["r/golang"] != 25 {
+		t.Errorf("expected r/golang to be adapted to 25, got %v", sizes)
+	}
+
+	// the adapted size is kept for the next fetches of the target
+	requests := len(fake.received())
+	if _, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, 25); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if made := len(fake.received()) - requests; made != 1 {
+		t.Errorf("expected a single request at the adapted size, got %d", made)
+	}
+}
+
+func TestAdaptSliceSizeToTruncatedResponses(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": `{"kind":"Listing","data":{"children":[`}}
+	client := newTestClient(t, fake)
+
+	if _, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, DefaultSliceSize); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	// 100, 50, 25, 12 and 10
+	if requests := len(fake.received()); requests != 5 {
+		t.Errorf("expected the slice size to fall back down to %d in 5 requests, got %d", MinSliceSize, requests)
+	}
+	if size := client.AdaptedSliceSizes()["r/golang"]; size != MinSliceSize {
+		t.Errorf("expected r/golang to be adapted to %d, got %d", MinSliceSize, size)
+	}
+}
+
+func TestNoSliceSizeFallBackOnSchemaDrift(t *testing.T) {
+
+	fake := &fakeReddit{expiresIn: 3600, pages: map[string]string{"/r/golang/new": `{"kind":"Listing","data":{"children":"none"}}`}}
+	client := newTestClient(t, fake)
+
+	if _, err := client.AllSubmissionsTo("golang", NewSubmissions, AllTime, DefaultSliceSize); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if requests := len(fake.received()); requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+	if sizes := client.AdaptedSliceSizes(); len(sizes) > 0 {
+		t.Errorf("expected no adapted slice size, got %v", sizes)
+	}
+}
+
+func TestNilSliceSizes(t *testing.T) {
+
+	var sizes *sliceSizes
+	sizes.set("r/golang", 10)
+	if size := sizes.get("r/golang"); size != DefaultSliceSize {
+		t.Errorf("expected %d, got %d", DefaultSliceSize, size)
+	}
+	if snapshot := sizes.snapshot(); len(snapshot) != 0 {
+		t.Errorf("expected an empty snapshot, got %v", snapshot)
+	}
+}
@@ -0,0 +1,70 @@
+package redditreadgo
+
+import "sort"
+
+// SubmissionOrder builds a sort.Slice compatible less function over the given submissions, e.g. sort.Slice(s, ByScore(s)).
+// The orders of this package sort nil submissions last, reversed or not.
+type SubmissionOrder func(submissions []*Submission) func(i, j int) bool
+
+// ByScore orders submissions by ascending score
+var ByScore SubmissionOrder = func(s []*Submission) func(i, j int) bool {
+	return nilsLast(s, func(a, b *Submission) bool { return a.Score < b.Score })
+}
+
+// ByCreated orders submissions from the oldest to the newest
+var ByCreated SubmissionOrder = func(s []*Submission) func(i, j int) bool {
+	return nilsLast(s, func(a, b *Submission) bool { return a.CreatedUTC < b.CreatedUTC })
+}
+
+// ByComments orders submissions by ascending number of comments
+var ByComments SubmissionOrder = func(s []*Submission) func(i, j int) bool {
+	return nilsLast(s, func(a, b *Submission) bool { return a.NumComments < b.NumComments })
+}
+
+// ByRatio orders submissions by ascending upvote ratio
+var ByRatio SubmissionOrder = func(s []*Submission) func(i, j int) bool {
+	return nilsLast(s, func(a, b *Submission) bool { return a.UpvoteRatio < b.UpvoteRatio })
+}
+
+// Reverse returns the descending variant of the order, nil submissions staying last
+func (o SubmissionOrder) Reverse() SubmissionOrder {
+	return func(s []*Submission) func(i, j int) bool {
+		less := o(s)
+		return func(i, j int) bool {
+			if s[i] == nil || s[j] == nil {
+				return s[i] != nil && s[j] == nil
+			}
+			return less(j, i)
+		}
+	}
+}
+
+// nilsLast returns a less function ordering nil submissions after all others, which it compares with the given one
+func nilsLast(s []*Submission, less func(a, b *Submission) bool) func(i, j int) bool {
+	return func(i, j int) bool {
+		if s[i] == nil || s[j] == nil {
+			return s[i] != nil && s[j] == nil
+		}
+		return less(s[i], s[j])
+	}
+}
+
+// MultiSort stably sorts submissions by the first order, breaking ties with each of the following ones
+func MultiSort(submissions []*Submission, orders ...SubmissionOrder) {
+	lessFns := make([]func(i, j int) bool, len(orders))
+	for index, order := range orders {
+		lessFns[index] = order(submissions)
+	}
+
+	sort.SliceStable(submissions, func(i, j int) bool {
+		for _, less := range lessFns {
+			switch {
+			case less(i, j):
+				return true
+			case less(j, i):
+				return false
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,82 @@
+package redditreadgo
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// order returns the ids of the submissions, nil ones as "-"
+func order(submissions []*Submission) string {
+	ids := make([]string, len(submissions))
+	for index, submission := range submissions {
+		ids[index] = "-"
+		if submission != nil {
+			ids[index] = submission.ID
+		}
+	}
+	return strings.Join(ids, " ")
+}
+
+func sortFixture() []*Submission {
+	return []*Submission{
+		{ID: "a", Score: 10, CreatedUTC: 300, NumComments: 5, UpvoteRatio: 0.5},
+		nil,
+		{ID: "b", Score: 30, CreatedUTC: 100, NumComments: 5, UpvoteRatio: 0.9},
+		{ID: "c", Score: 20, CreatedUTC: 200, NumComments: 1, UpvoteRatio: 0.7},
+		nil,
+		{ID: "d", Score: 10, CreatedUTC: 400, NumComments: 9, UpvoteRatio: 0.5},
+	}
+}
+
+func TestSubmissionOrders(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		order    SubmissionOrder
+		expected string
+	}{
+		{"ByScore", ByScore, "a d c b - -"},
+		{"ByCreated", ByCreated, "b c a d - -"},
+		{"ByComments", ByComments, "c a b d - -"},
+		{"ByRatio", ByRatio, "a d c b - -"},
+		{"ByScore reversed", ByScore.Reverse(), "b c a d - -"},
+		{"ByCreated reversed", ByCreated.Reverse(), "d a c b - -"},
+		{"ByComments reversed twice", ByComments.Reverse().Reverse(), "c a b d - -"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissions := sortFixture()
+			sort.SliceStable(submissions, test.order(submissions))
+			if sorted := order(submissions); sorted != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, sorted)
+			}
+		})
+	}
+}
+
+func TestMultiSort(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		orders   []SubmissionOrder
+		expected string
+	}{
+		{"no order keeps the order", nil, "a - b c - d"},
+		{"single order", []SubmissionOrder{ByScore}, "a d c b - -"},
+		{"ties broken by the next order", []SubmissionOrder{ByComments, ByScore.Reverse()}, "c b a d - -"},
+		{"ties broken by a reversed order", []SubmissionOrder{ByRatio, ByCreated.Reverse()}, "d a c b - -"},
+		{"ties left unbroken are stable", []SubmissionOrder{ByRatio}, "a d c b - -"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissions := sortFixture()
+			MultiSort(submissions, test.orders...)
+			if sorted := order(submissions); sorted != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, sorted)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package redditreadgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// StatusIndicator represents the overall severity reported by Reddit's status page
+type StatusIndicator string
+
+const (
+	// StatusOperational value
+	StatusOperational StatusIndicator = "none"
+	// StatusMinor value
+	StatusMinor StatusIndicator = "minor"
+	// StatusMajor value
+	StatusMajor StatusIndicator = "major"
+	// StatusCritical value
+	StatusCritical StatusIndicator = "critical"
+	// StatusMaintenance value
+	StatusMaintenance StatusIndicator = "maintenance"
+)
+
+// RedditStatus represents the overall status reported by Reddit's status page
+type RedditStatus struct {
+	Indicator   StatusIndicator
+	Description string
+	UpdatedAt   time.Time
+}
+
+// Operational reports whether Reddit reports no incident nor maintenance
+func (s *RedditStatus) Operational() bool {
+	return s.Indicator == StatusOperational
+}
+
+// StatusChecked is emitted after each successful CheckRedditStatus, so dashboards can tell Reddit outages apart
+// from client-side failures
+type StatusChecked struct {
+	At     time.Time
+	Status RedditStatus
+}
+
+// Time returns the moment the event occurred
+func (e StatusChecked) Time() time.Time { return e.At }
+
+// CheckRedditStatus returns the overall status reported by Reddit's status page and publishes it as a StatusChecked event
+func (c *ReadOnlyRedditClient) CheckRedditStatus(ctx context.Context) (*RedditStatus, error) {
+
+	if c == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	request, err := http.NewRequest("GET", StatusURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", c.userAgent)
+
+	httpClient := http.DefaultClient
+	if c.httpClient != nil {
+		httpClient = c.httpClient
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if code := response.StatusCode; code < 200 || code > 299 {
+		return nil, &StatusError{URL: StatusURL, StatusCode: response.StatusCode, Status: response.Status}
+	}
+
+	if err := checkJSONContentType(StatusURL, response.Header.Get("Content-Type")); err != nil {
+		return nil, err
+	}
+
+	responseBody, err := ioutil.ReadAll(io.LimitReader(response.Body, MaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var statusAsJSON struct {
+		Page struct {
+			UpdatedAt time.Time `json:"updated_at"`
+		} `json:"page"`
+		Status struct {
+			Indicator   StatusIndicator `json:"indicator"`
+			Description string          `json:"description"`
+		} `json:"status"`
+	}
+
+	if err := json.Unmarshal(responseBody, &statusAsJSON); err != nil {
+		return nil, err
+	}
+
+	status := &RedditStatus{
+		Indicator:   statusAsJSON.Status.Indicator,
+		Description: statusAsJSON.Status.Description,
+		UpdatedAt:   statusAsJSON.Page.UpdatedAt,
+	}
+
+	c.publish(StatusChecked{At: time.Now(), Status: *status})
+
+	return status, nil
+}
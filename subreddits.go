@@ -0,0 +1,398 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxAutocompleteLimit specifies the maximum no. of results returned by subreddit autocompletion
+const MaxAutocompleteLimit = 10
+
+// SubredditAutocomplete returns up to limit subreddits whose name starts with the given query
+func (c *ReadOnlyRedditClient) SubredditAutocomplete(query string, limit int) ([]*SubredditSuggestion, error) {
+
+	if len(query) == 0 {
+		return nil, errors.New("query cannot be null nor empty")
+	}
+
+	if limit < 1 || limit > MaxAutocompleteLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxAutocompleteLimit)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("query", query)
+	queryParams.Set("limit", strconv.Itoa(limit))
+	queryParams.Set("include_over_18", "true")
+	queryParams.Set("include_profiles", "false")
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/api/subreddit_autocomplete_v2?%v", QueryURL, queryParams.Encode())
+
+	type Response struct {
+		Kind string
+		Data struct {
+			Children []struct {
+				Kind string
+				Data *SubredditSuggestion
+			}
+		}
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*SubredditSuggestion, 0, len(response.Data.Children))
+	for _, child := range response.Data.Children {
+		if child.Kind == "t5" && child.Data != nil {
+			suggestions = append(suggestions, child.Data)
+		}
+	}
+
+	return suggestions, nil
+}
+
+// SearchSubreddits returns the subreddits whose name or description matches the given query, considering listing options
+func (c *ReadOnlyRedditClient) SearchSubreddits(query string, params ListingOptions) ([]*Subreddit, *SliceInfo, error) {
+
+	if len(query) == 0 {
+		return nil, nil, errors.New("query cannot be null nor empty")
+	}
+
+	if len(query) > MaxSearchQueryLength {
+		return nil, nil, fmt.Errorf("query cannot exceed %d characters", MaxSearchQueryLength)
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("q", query)
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	return c.subredditListing(fmt.Sprintf("%s/subreddits/search", QueryURL), queryParams)
+}
+
+// PopularSubreddits returns the subreddits with the most activity, considering listing options
+func (c *ReadOnlyRedditClient) PopularSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error) {
+	return c.subredditsWhere("popular", params)
+}
+
+// NewSubreddits returns the most recently created subreddits, considering listing options
+func (c *ReadOnlyRedditClient) NewSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error) {
+	return c.subredditsWhere("new", params)
+}
+
+// DefaultSubreddits returns the subreddits new users are subscribed to by default, considering listing options
+func (c *ReadOnlyRedditClient) DefaultSubreddits(params ListingOptions) ([]*Subreddit, *SliceInfo, error) {
+	return c.subredditsWhere("default", params)
+}
+
+// TrendingSubreddits returns the names of the subreddits Reddit currently reports as trending. The endpoint lists
+// names only, without pagination; see AboutSubreddit for their details.
+func (c *ReadOnlyRedditClient) TrendingSubreddits() ([]string, error) {
+
+	queryURL := fmt.Sprintf("%s/api/trending_subreddits?raw_json=1", QueryURL)
+
+	type Response struct {
+		SubredditNames []string `json:"subreddit_names"`
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	return response.SubredditNames, nil
+}
+
+func (c *ReadOnlyRedditClient) subredditsWhere(where string, params ListingOptions) ([]*Subreddit, *SliceInfo, error) {
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	return c.subredditListing(fmt.Sprintf("%s/subreddits/%s", QueryURL, where), queryParams)
+}
+
+// subredditListing queries the given listing of subreddits
+func (c *ReadOnlyRedditClient) subredditListing(endpoint string, queryParams url.Values) ([]*Subreddit, *SliceInfo, error) {
+
+	queryURL := fmt.Sprintf("%s?%v", endpoint, queryParams.Encode())
+
+	type Response struct {
+		Kind string
+		Data struct {
+			Children []struct {
+				Kind string
+				Data *Subreddit
+			}
+			After  string
+			Before string
+		}
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, nil, err
+	}
+
+	subreddits := make([]*Subreddit, 0, len(response.Data.Children))
+	for _, child := range response.Data.Children {
+		if child.Kind == "t5" && child.Data != nil {
+			subreddits = append(subreddits, child.Data)
+		}
+	}
+
+	return subreddits, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// RecommendedFor returns the names of subreddits related to the given ones, leaving out the omitted ones
+func (c *ReadOnlyRedditClient) RecommendedFor(subreddits []string, omit []string) ([]string, error) {
+
+	if len(subreddits) == 0 {
+		return nil, errors.New("subreddits cannot be null nor empty")
+	}
+
+	for _, subreddit := range append(append([]string{}, subreddits...), omit...) {
+		if err := validateSubredditName(subreddit); err != nil {
+			return nil, err
+		}
+	}
+
+	queryParams := url.Values{}
+	if len(omit) > 0 {
+		queryParams.Set("omit", strings.Join(omit, ","))
+	}
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/api/recommend/sr/%s?%v", QueryURL, strings.Join(subreddits, ","), queryParams.Encode())
+
+	var response []struct {
+		SubredditName string `json:"sr_name"`
+	}
+
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(response))
+	for index, recommendation := range response {
+		names[index] = recommendation.SubredditName
+	}
+
+	return names, nil
+}
+
+// AboutSubreddit returns the details of the given subreddit
+func (c *ReadOnlyRedditClient) AboutSubreddit(name string) (*Subreddit, error) {
+
+	if err := validateSubredditName(name); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/r/%s/about?raw_json=1", QueryURL, name)
+
+	type Response struct {
+		Kind string
+		Data *Subreddit
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	// unknown subreddits may be answered with a search listing instead of a subreddit
+	if response.Kind != "t5" || response.Data == nil {
+		return nil, fmt.Errorf("no subreddit returned for %s", name)
+	}
+
+	return response.Data, nil
+}
+
+// RulesOf returns the rules of the given subreddit, in priority order
+func (c *ReadOnlyRedditClient) RulesOf(subreddit string) ([]*SubredditRule, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/r/%s/about/rules?raw_json=1", QueryURL, subreddit)
+
+	type Response struct {
+		Rules []*SubredditRule `json:"rules"`
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	return response.Rules, nil
+}
+
+// ModeratorsOf returns the moderators of the given subreddit, along with their permissions
+func (c *ReadOnlyRedditClient) ModeratorsOf(subreddit string) ([]*Moderator, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/r/%s/about/moderators?raw_json=1", QueryURL, subreddit)
+
+	type Response struct {
+		Kind string
+		Data struct {
+			Children []*Moderator
+		}
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	return response.Data.Children, nil
+}
+
+// LinkFlairsOf returns the link flair templates of the given subreddit, i.e. the flairs its submissions can carry
+func (c *ReadOnlyRedditClient) LinkFlairsOf(subreddit string) ([]*FlairTemplate, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/r/%s/api/link_flair_v2?raw_json=1", QueryURL, subreddit)
+
+	var response []*FlairTemplate
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// EmojisOf returns the custom emojis of the given subreddit along with the snoomojis available everywhere, by name
+func (c *ReadOnlyRedditClient) EmojisOf(subreddit string) ([]*Emoji, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/api/v1/%s/emojis/all?raw_json=1", QueryURL, subreddit)
+
+	// emojis are grouped by owner, the snoomojis group or the fullname of the subreddit, then keyed by name
+	var response map[string]map[string]*Emoji
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, err
+	}
+
+	emojis := make([]*Emoji, 0)
+	for owner, group := range response {
+		for name, emoji := range group {
+			if emoji == nil {
+				continue
+			}
+			emoji.Name = name
+			emoji.Snoomoji = owner == "snoomojis"
+			emojis = append(emojis, emoji)
+		}
+	}
+
+	sort.Slice(emojis, func(i, j int) bool {
+		return emojis[i].Name < emojis[j].Name
+	})
+
+	return emojis, nil
+}
+
+// GildedIn returns the awarded submissions and comments of the given subreddit, interleaved, considering listing options
+func (c *ReadOnlyRedditClient) GildedIn(subreddit string, params ListingOptions) ([]*Thing, *SliceInfo, error) {
+
+	if err := validateSubreddit(subreddit); err != nil {
+		return nil, nil, err
+	}
+
+	queryParams, err := c.listingValues(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams.Set("raw_json", strconv.Itoa(1))
+
+	queryURL := fmt.Sprintf("%s/r/%s/gilded?%v", QueryURL, subreddit, queryParams.Encode())
+
+	var response listing
+	if err := c.doGetRequest(queryURL, &response); err != nil {
+		return nil, nil, err
+	}
+
+	things, err := decodeThings(response.Data.Children)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return things, &SliceInfo{Before: response.Data.Before, After: response.Data.After}, nil
+}
+
+// MaxStickies specifies the maximum no. of submissions a subreddit can sticky
+const MaxStickies = 2
+
+// StickiesOf returns the submissions stickied to the given subreddit, in display order
+func (c *ReadOnlyRedditClient) StickiesOf(subreddit string) ([]*Submission, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	// stickies always lead the hot listing
+	submissions, _, err := c.SubmissionsTo(subreddit, HotSubmissions, AllTime, ListingOptions{Limit: MaxStickies})
+	if err != nil {
+		return nil, err
+	}
+
+	stickies := make([]*Submission, 0, MaxStickies)
+	for _, submission := range submissions {
+		if submission.Stickied {
+			stickies = append(stickies, submission)
+		}
+	}
+
+	return stickies, nil
+}
+
+// StylesheetOf returns the custom CSS and stylesheet images of the given subreddit
+func (c *ReadOnlyRedditClient) StylesheetOf(subreddit string) (*Stylesheet, error) {
+
+	if err := validateSubredditName(subreddit); err != nil {
+		return nil, err
+	}
+
+	queryURL := fmt.Sprintf("%s/r/%s/about/stylesheet?raw_json=1", QueryURL, subreddit)
+
+	type Response struct {
+		Kind string
+		Data *Stylesheet
+	}
+
+	response := new(Response)
+	if err := c.doGetRequest(queryURL, response); err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return nil, fmt.Errorf("no stylesheet returned for %s", subreddit)
+	}
+
+	return response.Data, nil
+}
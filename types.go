@@ -36,6 +36,56 @@ const (
 	AllTime AgeSort = "all"
 )
 
+// CommentSort represents the possible ways to sort the comments of a submission.
+type CommentSort string
+
+const (
+	// DefaultCommentSort value, the submission's suggested sort or confidence
+	DefaultCommentSort CommentSort = ""
+	// ConfidenceComments value, also known as best
+	ConfidenceComments CommentSort = "confidence"
+	// TopComments value
+	TopComments CommentSort = "top"
+	// NewComments value
+	NewComments CommentSort = "new"
+	// ControversialComments value
+	ControversialComments CommentSort = "controversial"
+	// OldComments value
+	OldComments CommentSort = "old"
+	// QAComments value, answers of the submission author first
+	QAComments CommentSort = "qa"
+)
+
+// SearchSort represents the possible ways to sort search results.
+type SearchSort string
+
+const (
+	// DefaultSearchSort value, relevance
+	DefaultSearchSort SearchSort = ""
+	// RelevanceResults value
+	RelevanceResults SearchSort = "relevance"
+	// HotResults value
+	HotResults SearchSort = "hot"
+	// TopResults value
+	TopResults SearchSort = "top"
+	// NewResults value
+	NewResults SearchSort = "new"
+	// MostCommentedResults value
+	MostCommentedResults SearchSort = "comments"
+)
+
+// ShowOption represents the possible values of the show listing parameter. Reddit honors it on subreddit and user
+// listings (hot, new, rising, top, controversial, submitted), where it disables the account preferences hiding
+// some items. Application-only sessions have no such preferences, so it only matters for user-authorized tokens.
+type ShowOption string
+
+const (
+	// ShowDefault value
+	ShowDefault ShowOption = ""
+	// ShowAll value
+	ShowAll ShowOption = "all"
+)
+
 // Region represents the possible values for querying by region
 type Region string
 
@@ -0,0 +1,38 @@
+package redditreadgo
+
+import "sync"
+
+// userAgentRotation hands out user agents in round-robin order. A nil value always hands out the fallback.
+type userAgentRotation struct {
+	mutex      sync.Mutex
+	userAgents []string
+	position   int
+}
+
+func newUserAgentRotation(userAgents []string) *userAgentRotation {
+	rotation := &userAgentRotation{}
+	for _, userAgent := range userAgents {
+		if len(userAgent) > 0 {
+			rotation.userAgents = append(rotation.userAgents, userAgent)
+		}
+	}
+
+	if len(rotation.userAgents) == 0 {
+		return nil
+	}
+	return rotation
+}
+
+// next returns the user agent for the next request and advances the rotation
+func (r *userAgentRotation) next(fallback string) string {
+	if r == nil {
+		return fallback
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	userAgent := r.userAgents[r.position]
+	r.position = (r.position + 1) % len(r.userAgents)
+	return userAgent
+}
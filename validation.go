@@ -0,0 +1,150 @@
+package redditreadgo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxTotal specifies the largest total accepted by the AllSubmissions methods
+const MaxTotal = 1 << 20
+
+// AllSubreddit and PopularSubreddit specify the pseudo subreddits aggregating all subreddits, resp. the popular ones
+const (
+	AllSubreddit     = "all"
+	PopularSubreddit = "popular"
+)
+
+var subredditNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_]{1,20}$`)
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,20}$`)
+
+func validateSubreddit(subreddit string) error {
+
+	if len(subreddit) == 0 {
+		return errors.New("subreddit cannot be null nor empty")
+	}
+
+	names := strings.Split(subreddit, "+")
+	for _, name := range names {
+		// r/all may exclude subreddits, as in all-a-b
+		if parts := strings.Split(name, "-"); strings.EqualFold(parts[0], AllSubreddit) && len(parts) > 1 {
+			for _, excluded := range parts[1:] {
+				if !subredditNamePattern.MatchString(excluded) {
+					return fmt.Errorf("invalid subreddit name: %q", excluded)
+				}
+			}
+			name = parts[0]
+		}
+
+		if !subredditNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid subreddit name: %q", name)
+		}
+
+		if len(names) > 1 && (strings.EqualFold(name, AllSubreddit) || strings.EqualFold(name, PopularSubreddit)) {
+			return fmt.Errorf("%s cannot be combined with other subreddits", name)
+		}
+	}
+
+	return nil
+}
+
+// validateSubredditName validates a single subreddit name, for endpoints not accepting a+b combinations
+func validateSubredditName(subreddit string) error {
+
+	if len(subreddit) == 0 {
+		return errors.New("subreddit cannot be null nor empty")
+	}
+
+	if !subredditNamePattern.MatchString(subreddit) {
+		return fmt.Errorf("invalid subreddit name: %q", subreddit)
+	}
+
+	return nil
+}
+
+func validateAuthor(author string) error {
+
+	if len(author) == 0 {
+		return errors.New("author cannot be null nor empty")
+	}
+
+	if !usernamePattern.MatchString(author) {
+		return fmt.Errorf("invalid author name: %q", author)
+	}
+
+	return nil
+}
+
+func validateTotal(total int) error {
+
+	if total < 0 {
+		return errors.New("total cannot be negative")
+	}
+
+	if total > MaxTotal {
+		return fmt.Errorf("total cannot exceed %d", MaxTotal)
+	}
+
+	return nil
+}
+
+func (s PopularitySort) validate() error {
+	switch s {
+	case DefaultPopularity, HotSubmissions, NewSubmissions, RisingSubmissions, TopSubmissions, ControversialSubmissions:
+		return nil
+	}
+	return fmt.Errorf("invalid popularity sort: %q", string(s))
+}
+
+func (a AgeSort) validate() error {
+	switch a {
+	case "", ThisHour, ThisDay, ThisWeek, ThisMonth, ThisYear, AllTime:
+		return nil
+	}
+	return fmt.Errorf("invalid age sort: %q", string(a))
+}
+
+func (o ShowOption) validate() error {
+	switch o {
+	case ShowDefault, ShowAll:
+		return nil
+	}
+	return fmt.Errorf("invalid show option: %q", string(o))
+}
+
+func (s CommentSort) validate() error {
+	switch s {
+	case DefaultCommentSort, ConfidenceComments, TopComments, NewComments, ControversialComments, OldComments, QAComments:
+		return nil
+	}
+	return fmt.Errorf("invalid comment sort: %q", string(s))
+}
+
+func (s SearchSort) validate() error {
+	switch s {
+	case DefaultSearchSort, RelevanceResults, HotResults, TopResults, NewResults, MostCommentedResults:
+		return nil
+	}
+	return fmt.Errorf("invalid search sort: %q", string(s))
+}
+
+// CombinedSubreddits returns the a+b+c expression querying the given subreddits at once, e.g. with SubmissionsTo.
+// Names may carry an r/ prefix; empty and duplicate names are left out.
+func CombinedSubreddits(subreddits []string) string {
+
+	seen := make(map[string]bool, len(subreddits))
+	names := make([]string, 0, len(subreddits))
+	for _, subreddit := range subreddits {
+		name := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(subreddit), "/"), "r/")
+		key := strings.ToLower(name)
+		if len(name) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+
+	return strings.Join(names, "+")
+}
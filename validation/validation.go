@@ -0,0 +1,150 @@
+// Package validation checks fetched data for anomalies, catching silent decode breakage caused by Reddit schema drift
+// before it spreads into datasets.
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// MaxPlausibleScore specifies the largest submission score considered possible; the highest ever is below 500k
+const MaxPlausibleScore = 10000000
+
+// redditLaunch is the creation time of the oldest submissions, in seconds since the epoch
+const redditLaunch = 1118707200
+
+// Anomaly represents a single problem found in a submission
+type Anomaly struct {
+	// SubmissionID is empty when the submission has no id, see Index
+	SubmissionID string
+	// Index is the position of the submission in the checked slice
+	Index   int
+	Field   string
+	Problem string
+}
+
+func (a Anomaly) String() string {
+	return fmt.Sprintf("submission %q at index %d: %s %s", a.SubmissionID, a.Index, a.Field, a.Problem)
+}
+
+// CheckSubmission returns the anomalies of the given submission, found at the given index
+func CheckSubmission(index int, submission *redditreadgo.Submission) []Anomaly {
+
+	if submission == nil {
+		return []Anomaly{{Index: index, Field: "submission", Problem: "is nil"}}
+	}
+
+	var anomalies []Anomaly
+	report := func(field string, problem string, args ...interface{}) {
+		anomalies = append(anomalies, Anomaly{SubmissionID: submission.ID, Index: index, Field: field, Problem: fmt.Sprintf(problem, args...)})
+	}
+
+	if len(submission.ID) == 0 {
+		report("id", "is empty")
+	}
+	if len(submission.Name) == 0 {
+		report("name", "is empty")
+	} else if len(submission.ID) > 0 && submission.Name != "t3_"+submission.ID {
+		report("name", "%q does not match the id", submission.Name)
+	}
+
+	now := float64(time.Now().Add(24 * time.Hour).Unix())
+	switch {
+	case submission.CreatedUTC == 0:
+		report("created_utc", "is zero")
+	case submission.CreatedUTC < redditLaunch || submission.CreatedUTC > now:
+		report("created_utc", "%v is out of range", submission.CreatedUTC)
+	}
+
+	if submission.Score > MaxPlausibleScore {
+		report("score", "%d is implausible", submission.Score)
+	}
+	if submission.UpvoteRatio < 0 || submission.UpvoteRatio > 1 {
+		report("upvote_ratio", "%v is out of range", submission.UpvoteRatio)
+	}
+	if submission.Ups < 0 {
+		report("ups", "%d is negative", submission.Ups)
+	}
+
+	if len(submission.Subreddit) == 0 {
+		report("subreddit", "is empty")
+	}
+
+	return anomalies
+}
+
+// Report represents the anomalies found during a crawl
+type Report struct {
+	// Checked is the no. of submissions checked
+	Checked   int
+	Anomalies []Anomaly
+}
+
+// Valid reports whether no anomaly was found
+func (r *Report) Valid() bool {
+	return len(r.Anomalies) == 0
+}
+
+// ByField returns the no. of anomalies per field, e.g. to spot a field Reddit renamed
+func (r *Report) ByField() map[string]int {
+	counts := make(map[string]int)
+	for _, anomaly := range r.Anomalies {
+		counts[anomaly.Field]++
+	}
+	return counts
+}
+
+func (r *Report) String() string {
+	counts := r.ByField()
+	fields := make([]string, 0, len(counts))
+	for field := range counts {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	summary := make([]string, len(fields))
+	for index, field := range fields {
+		summary[index] = fmt.Sprintf("%s: %d", field, counts[field])
+	}
+	return fmt.Sprintf("%d submissions checked, %d anomalies (%s)", r.Checked, len(r.Anomalies), strings.Join(summary, ", "))
+}
+
+// Validator accumulates the anomalies of the submissions fetched during a crawl. It is safe for concurrent use.
+type Validator struct {
+	mutex  sync.Mutex
+	report Report
+}
+
+// NewValidator creates a validator with an empty report
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Check checks the given submissions, e.g. a page just fetched, and returns their anomalies
+func (v *Validator) Check(submissions []*redditreadgo.Submission) []Anomaly {
+
+	var anomalies []Anomaly
+	for index, submission := range submissions {
+		anomalies = append(anomalies, CheckSubmission(index, submission)...)
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.report.Checked += len(submissions)
+	v.report.Anomalies = append(v.report.Anomalies, anomalies...)
+	return anomalies
+}
+
+// Report returns a copy of the report accumulated so far
+func (v *Validator) Report() *Report {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	return &Report{Checked: v.report.Checked, Anomalies: append([]Anomaly(nil), v.report.Anomalies...)}
+}
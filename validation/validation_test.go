@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MihaiBogdanEugen/redditreadgo"
+)
+
+// valid returns a submission without anomalies, changed by the given function
+func valid(change func(*redditreadgo.Submission)) *redditreadgo.Submission {
+	submission := &redditreadgo.Submission{ID: "abc", Name: "t3_abc", CreatedUTC: 1530000000, Score: 42, Ups: 42, UpvoteRatio: 0.9, Subreddit: "golang"}
+	if change != nil {
+		change(submission)
+	}
+	return submission
+}
+
+func TestCheckSubmission(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		submission *redditreadgo.Submission
+		// expected lists the fields reported, in order
+		expected []string
+	}{
+		{"valid", valid(nil), nil},
+		{"nil", nil, []string{"submission"}},
+		{"empty id", valid(func(s *redditreadgo.Submission) { s.ID = "" }), []string{"id"}},
+		{"empty name", valid(func(s *redditreadgo.Submission) { s.Name = "" }), []string{"name"}},
+		{"name not matching the id", valid(func(s *redditreadgo.Submission) { s.Name = "t3_xyz" }), []string{"name"}},
+		{"comment name", valid(func(s *redditreadgo.Submission) { s.Name = "t1_abc" }), []string{"name"}},
+		{"zero created_utc", valid(func(s *redditreadgo.Submission) { s.CreatedUTC = 0 }), []string{"created_utc"}},
+		{"created_utc before reddit", valid(func(s *redditreadgo.Submission) { s.CreatedUTC = redditLaunch - 1 }), []string{"created_utc"}},
+		{"created_utc in the future", valid(func(s *redditreadgo.Submission) { s.CreatedUTC = float64(time.Now().Add(48 * time.Hour).Unix()) }), []string{"created_utc"}},
+		{"created_utc in milliseconds", valid(func(s *redditreadgo.Submission) { s.CreatedUTC = 1530000000000 }), []string{"created_utc"}},
+		{"upvote_ratio below 0", valid(func(s *redditreadgo.Submission) { s.UpvoteRatio = -0.1 }), []string{"upvote_ratio"}},
+		{"upvote_ratio above 1", valid(func(s *redditreadgo.Submission) { s.UpvoteRatio = 1.5 }), []string{"upvote_ratio"}},
+		{"upvote_ratio bounds", valid(func(s *redditreadgo.Submission) { s.UpvoteRatio = 1 }), nil},
+		{"implausible score", valid(func(s *redditreadgo.Submission) { s.Score = MaxPlausibleScore + 1 }), []string{"score"}},
+		{"negative ups", valid(func(s *redditreadgo.Submission) { s.Ups = -1 }), []string{"ups"}},
+		{"empty subreddit", valid(func(s *redditreadgo.Submission) { s.Subreddit = "" }), []string{"subreddit"}},
+		{"several", valid(func(s *redditreadgo.Submission) { s.ID, s.CreatedUTC, s.Subreddit = "", 0, "" }), []string{"id", "created_utc", "subreddit"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			anomalies := CheckSubmission(7, test.submission)
+
+			fields := make([]string, len(anomalies))
+			for index, anomaly := range anomalies {
+				fields[index] = anomaly.Field
+				if anomaly.Index != 7 {
+					t.Errorf("expected index 7, got %d", anomaly.Index)
+				}
+				if test.submission != nil && anomaly.SubmissionID != test.submission.ID {
+					t.Errorf("expected submission id %q, got %q", test.submission.ID, anomaly.SubmissionID)
+				}
+			}
+			if fmt.Sprint(fields) != fmt.Sprint(test.expected) {
+				t.Errorf("expected anomalies of %v, got %v", test.expected, anomalies)
+			}
+		})
+	}
+}
+
+func TestValidatorConcurrentChecks(t *testing.T) {
+
+	validator := NewValidator()
+	page := []*redditreadgo.Submission{valid(nil), nil, valid(func(s *redditreadgo.Submission) { s.UpvoteRatio = 2 })}
+
+	var group sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for round := 0; round < 10; round++ {
+				if anomalies := validator.Check(page); len(anomalies) != 2 {
+					t.Errorf("expected 2 anomalies per page, got %d", len(anomalies))
+				}
+				validator.Report()
+			}
+		}()
+	}
+	group.Wait()
+
+	report := validator.Report()
+	if report.Checked != 240 || len(report.Anomalies) != 160 || report.Valid() {
+		t.Fatalf("expected 240 submissions checked and 160 anomalies, got %s", report)
+	}
+
+	counts := report.ByField()
+	if counts["submission"] != 80 || counts["upvote_ratio"] != 80 {
+		t.Errorf("unexpected anomalies per field %v", counts)
+	}
+	if summary := report.String(); summary != "240 submissions checked, 160 anomalies (submission: 80, upvote_ratio: 80)" {
+		t.Errorf("unexpected summary %q", summary)
+	}
+
+	// the report returned is a copy
+	report.Anomalies[0].Field = "changed"
+	if validator.Report().Anomalies[0].Field == "changed" {
+		t.Error("report shares its anomalies with the validator")
+	}
+}
+
+func TestEmptyReport(t *testing.T) {
+
+	report := NewValidator().Report()
+	if !report.Valid() || report.String() != "0 submissions checked, 0 anomalies ()" {
+		t.Errorf("unexpected empty report %s", report)
+	}
+}